@@ -15,184 +15,286 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/binance/ticker/24hr": {
+        "/gainers": {
             "get": {
-                "description": "Retrieve 24-hour ticker data for all trading pairs.",
+                "description": "This function fetches 24-hour tickers from the spot and derivative markets of every configured exchange, normalizes them and returns one ranked list. Option markets are only ranked when requested with market.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Binance"
+                    "Aggregate"
                 ],
-                "summary": "Get 24-hour ticker data",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/binance.TickerData"
-                                }
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/binance/ticker/24hr/gainers": {
-            "get": {
-                "description": "Retrieve the top gainers with a specified limit, filtered by ending.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Binance"
-                ],
-                "summary": "Get the top gainers with a specified limit, filtered by ending.",
+                "summary": "Retrieve top gainers ranked across every configured exchange.",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Limit the number of results",
+                        "default": 500,
+                        "description": "Limit the number of results; default is 500",
                         "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter results by ending",
+                        "description": "Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD",
                         "name": "endingFilter",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to drop, matched by excludeMode",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "base",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of exclude; default is contains (symbol substring)",
+                        "name": "excludeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to keep, matched by includeMode",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "base",
+                            "contains",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of include; default is base (exact base asset)",
+                        "name": "includeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)",
+                        "name": "excludeLeveraged",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)",
+                        "name": "excludeStablePairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)",
+                        "name": "excludeFiatPairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of exchanges to include; default is all",
+                        "name": "exchange",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated market types to include, e.g. spot,linear; default is every market except option",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at least this percentage",
+                        "name": "minChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at most this percentage",
+                        "name": "maxChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount",
+                        "name": "minQuoteVolume",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Keep pairs with at least this many trades; applied where the exchange reports trade counts",
+                        "name": "minTradeCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose last price is at least this value",
+                        "name": "minLastPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose bid/ask spread is at most this percentage of the mid price",
+                        "name": "maxSpreadPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Drop dust-priced pairs whose price tick is more than this percentage of the last price",
+                        "name": "maxTickPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression over normalized ticker fields, e.g. change_pct \u003e 5 \u0026\u0026 quote_volume \u003e 1e6",
+                        "name": "filter",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Ranked list of normalized tickers representing top gainers",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/binance.TickerData"
-                                }
-                            }
+                            "$ref": "#/definitions/handler.AggregatedGainers"
                         }
+                    },
+                    "400": {
+                        "description": "Unknown exchange or market, invalid limit, threshold or filter"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/binance/ticker/24hr/gainers/pairs": {
+        "/spread": {
             "get": {
-                "description": "Retrieve the top gainers with a specified limit, filtered by ending and exclusion.",
+                "description": "This function fetches 24-hour tickers of the same market on two exchanges and, for every pair listed on both, returns the last price and best bid/ask on each venue with the percentage spread between them.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Binance"
+                    "Aggregate"
                 ],
-                "summary": "Get the top gainers with a specified limit, filtered by ending and exclusion.",
+                "summary": "Retrieve the cross-exchange price spread of every common pair.",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Limit the number of results",
-                        "name": "limit",
+                        "type": "string",
+                        "default": "binance",
+                        "description": "First exchange; default is binance",
+                        "name": "first",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter results by ending",
-                        "name": "endingFilter",
+                        "default": "bybit",
+                        "description": "Second exchange; default is bybit",
+                        "name": "second",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Exclude results with specific ending",
-                        "name": "exclude",
+                        "default": "spot",
+                        "description": "Market type supported by both exchanges; default is spot",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Limit the number of results; 0 returns every pair",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "List of spreads, largest gap first",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/handler.PairListResponse"
+                                "$ref": "#/definitions/common.Spread"
                             }
                         }
+                    },
+                    "400": {
+                        "description": "Unknown exchange, unsupported market type or invalid limit"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/binance/ticker/24hr/{pair}": {
+        "/{exchange}/depth/{pair}": {
             "get": {
-                "description": "Retrieve ticker data for a specific trading pair.",
+                "description": "This function returns the order book of a trading pair, best levels first, with its mid price, spread in basis points and the quote notional resting within 0.5%, 1% and 2% of the mid price on each side.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Binance"
+                    "Exchanges"
                 ],
-                "summary": "Get ticker data for a specific trading pair",
+                "summary": "Retrieve the order book and liquidity metrics of a trading pair.",
                 "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "Trading pair symbol (e.g., BTCUSDT)",
                         "name": "pair",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.Ticker"
-                        }
-                    }
-                }
-            }
-        },
-        "/bybit/ticker/24hr": {
-            "get": {
-                "description": "This function fetches the 24-hour ticker data for all trading pairs in a given market (Spot, Linear, Option, or Inverse).",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Bybit"
-                ],
-                "summary": "Retrieve 24-hour ticker data for the specified market type in Bybit.",
-                "parameters": [
+                    },
                     {
-                        "enum": [
-                            "spot",
-                            "linear",
-                            "option",
-                            "inverse"
-                        ],
                         "type": "string",
-                        "default": "spot",
-                        "description": "Market type (spot, linear, option, inverse)",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse",
                         "name": "market",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 500,
+                        "description": "Levels per side; default is 500, capped by the exchange",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Order size in the quote asset to estimate slippage for",
+                        "name": "notional",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of TickerData representing 24-hour ticker information for each trading pair",
+                        "description": "Order book with liquidity metrics",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/binance.TickerData"
-                                }
-                            }
+                            "$ref": "#/definitions/common.DepthReport"
                         }
                     },
                     "400": {
-                        "description": "Invalid market type provided"
+                        "description": "Invalid market type, limit or notional"
+                    },
+                    "404": {
+                        "description": "Trading pair symbol is not listed in the market"
                     },
                     "500": {
                         "description": "Internal Server Error"
@@ -200,59 +302,89 @@ const docTemplate = `{
                 }
             }
         },
-        "/bybit/ticker/24hr/gainers": {
+        "/{exchange}/indicators/{pair}": {
             "get": {
-                "description": "This function fetches trading pairs that have shown significant price gains over the last 24 hours in a specified market.",
+                "description": "This function fetches the candles of a trading pair and returns the latest value of every indicator of the set.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bybit"
+                    "Exchanges"
                 ],
-                "summary": "Retrieve top gainers in a specified market with an optional limit and ending filter.",
+                "summary": "Compute technical indicators of a trading pair.",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 500,
-                        "description": "Limit the number of results; default is 500",
-                        "name": "limit",
-                        "in": "query"
+                        "enum": [
+                            "binance",
+                            "bybit"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Filter results by a specific ending symbol",
-                        "name": "endingFilter",
+                        "description": "Trading pair symbol (e.g., BTCUSDT)",
+                        "name": "pair",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, inverse",
+                        "name": "market",
                         "in": "query"
                     },
                     {
                         "enum": [
-                            "spot",
-                            "linear",
-                            "option",
-                            "inverse"
+                            "1m",
+                            "3m",
+                            "5m",
+                            "15m",
+                            "30m",
+                            "1h",
+                            "2h",
+                            "4h",
+                            "6h",
+                            "12h",
+                            "1d",
+                            "1w",
+                            "1M"
                         ],
                         "type": "string",
-                        "default": "spot",
-                        "description": "Market type (spot, linear, option, inverse); default is 'spot'",
-                        "name": "market",
+                        "default": "1h",
+                        "description": "Candle interval",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "rsi,macd",
+                        "description": "Comma-separated indicators with optional periods, e.g. rsi,macd,sma:50",
+                        "name": "set",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Compute as of the candle open at this time, in Unix milliseconds or RFC 3339; default is now",
+                        "name": "endTime",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of TickerData representing top gainers",
+                        "description": "Latest indicator values keyed by indicator",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/binance.TickerData"
-                                }
-                            }
+                            "$ref": "#/definitions/indicators.Snapshot"
                         }
                     },
                     "400": {
-                        "description": "Invalid market type or query parameters"
+                        "description": "Invalid market type, interval, set or time"
+                    },
+                    "404": {
+                        "description": "Trading pair symbol is not listed in the market"
                     },
                     "500": {
                         "description": "Internal Server Error"
@@ -260,64 +392,98 @@ const docTemplate = `{
                 }
             }
         },
-        "/bybit/ticker/24hr/gainers/pairs": {
+        "/{exchange}/klines/{pair}": {
             "get": {
-                "description": "This function fetches trading pairs that have shown significant price gains over the last 24 hours.",
+                "description": "This function returns the candles of a trading pair in the exchange-agnostic common.Candle format, oldest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bybit"
+                    "Exchanges"
                 ],
-                "summary": "Retrieve top gainers in a specified market with filtering options.",
+                "summary": "Retrieve historical OHLCV candles of a trading pair.",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 100,
-                        "description": "Limit the number of results; default is 100",
-                        "name": "limit",
-                        "in": "query"
+                        "enum": [
+                            "binance",
+                            "bybit"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "default": "USDT",
-                        "description": "Filter results by a specific ending symbol; default is 'USDT'",
-                        "name": "endingFilter",
-                        "in": "query"
+                        "description": "Trading pair symbol (e.g., BTCUSDT)",
+                        "name": "pair",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "default": "BNB",
-                        "description": "Exclude results with a specific ending symbol; default is 'BNB'",
-                        "name": "exclude",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, inverse",
+                        "name": "market",
                         "in": "query"
                     },
                     {
                         "enum": [
-                            "spot",
-                            "linear",
-                            "option",
-                            "inverse"
+                            "1m",
+                            "3m",
+                            "5m",
+                            "15m",
+                            "30m",
+                            "1h",
+                            "2h",
+                            "4h",
+                            "6h",
+                            "12h",
+                            "1d",
+                            "1w",
+                            "1M"
                         ],
                         "type": "string",
-                        "default": "spot",
-                        "description": "Market type (spot, linear, option, inverse); default is 'spot'",
-                        "name": "market",
+                        "default": "1h",
+                        "description": "Candle interval",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Open time of the first candle, in Unix milliseconds or RFC 3339",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest open time of the last candle, in Unix milliseconds or RFC 3339",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 500,
+                        "description": "Number of candles; default is 500, at most 10000",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of PairListResponse representing top gainers",
+                        "description": "Candles, oldest first",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/handler.PairListResponse"
+                                "$ref": "#/definitions/common.Candle"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid market type or query parameters"
+                        "description": "Invalid market type, interval, time or limit"
+                    },
+                    "404": {
+                        "description": "Trading pair symbol is not listed in the market"
                     },
                     "500": {
                         "description": "Internal Server Error"
@@ -325,211 +491,2927 @@ const docTemplate = `{
                 }
             }
         },
-        "/bybit/ticker/24hr/{pair}": {
+        "/{exchange}/symbols": {
             "get": {
-                "description": "This function fetches the latest ticker information for a specified trading pair in a chosen market.",
+                "description": "This function returns the base asset, quote asset, status, tick size and lot size of every symbol in a market of the exchange.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bybit"
+                    "Exchanges"
                 ],
-                "summary": "Retrieve ticker data for a specific trading pair.",
+                "summary": "Retrieve symbol metadata.",
                 "parameters": [
                     {
+                        "enum": [
+                            "binance",
+                            "bybit",
+                            "okx",
+                            "kraken",
+                            "coinbase",
+                            "kucoin"
+                        ],
                         "type": "string",
-                        "description": "Trading pair symbol (e.g., BTCUSDT)",
-                        "name": "pair",
+                        "description": "Exchange name",
+                        "name": "exchange",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "enum": [
-                            "spot",
-                            "linear",
-                            "option",
-                            "inverse"
-                        ],
                         "type": "string",
-                        "default": "spot",
-                        "description": "Market type (spot, linear, option, inverse); default is 'spot'",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot",
                         "name": "market",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "TickerData object containing detailed ticker information for the specified pair",
+                        "description": "Symbol metadata keyed by symbol",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/binance.TickerData"
-                            }
+                            "$ref": "#/definitions/common.Symbols"
                         }
                     },
                     "400": {
-                        "description": "Invalid market type or trading pair symbol"
+                        "description": "Invalid market type"
                     },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "binance.TickerData": {
-            "type": "object",
-            "properties": {
-                "askPrice": {
-                    "type": "string"
-                },
-                "askQty": {
-                    "type": "string"
-                },
-                "bidPrice": {
-                    "type": "string"
-                },
-                "bidQty": {
-                    "type": "string"
-                },
-                "closeTime": {
-                    "type": "number"
-                },
-                "count": {
-                    "type": "integer"
-                },
-                "firstId": {
-                    "type": "number"
-                },
-                "highPrice": {
-                    "type": "string"
+        },
+        "/{exchange}/ticker/24hr": {
+            "get": {
+                "description": "This function fetches the 24-hour ticker data for all trading pairs in a given market of the exchange.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchanges"
+                ],
+                "summary": "Retrieve 24-hour ticker data for all trading pairs of an exchange.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit",
+                            "okx",
+                            "kraken",
+                            "coinbase",
+                            "kucoin"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Underlying of the option market on exchanges that list options per base coin (bybit); defaults to BTC",
+                        "name": "baseCoin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Return exchange-agnostic common.Ticker rows instead of the native format",
+                        "name": "normalized",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated trading pairs to return, e.g. BTCUSDT,ETHUSDT; native tickers require binance or bybit",
+                        "name": "symbols",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size; default is 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of rows to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page; takes precedence over offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "JSON field of the rows to sort by, e.g. quoteVolume or change_percent with normalized=true",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order: desc (default) or asc; without sort, asc reverses the list",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated JSON fields to keep in every row",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of native or normalized ticker data for each trading pair",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "object"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid market type, normalized, symbols or paging parameters"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/{exchange}/ticker/24hr/gainers": {
+            "get": {
+                "description": "This function fetches trading pairs that have shown price gains over the last 24 hours in a specified market of the exchange.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchanges"
+                ],
+                "summary": "Retrieve top gainers in a specified market with an optional limit and ending filter.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit",
+                            "okx",
+                            "kraken",
+                            "coinbase",
+                            "kucoin"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 500,
+                        "description": "Limit the number of results; default is 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD",
+                        "name": "endingFilter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to drop, matched by excludeMode",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "base",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of exclude; default is contains (symbol substring)",
+                        "name": "excludeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to keep, matched by includeMode",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "base",
+                            "contains",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of include; default is base (exact base asset)",
+                        "name": "includeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)",
+                        "name": "excludeLeveraged",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)",
+                        "name": "excludeStablePairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)",
+                        "name": "excludeFiatPairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Underlying of the option market (bybit); defaults to BTC",
+                        "name": "baseCoin",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "volume",
+                            "turnover",
+                            "open_interest",
+                            "iv",
+                            "change"
+                        ],
+                        "type": "string",
+                        "default": "volume",
+                        "description": "Ranking key of the option market (bybit); default is volume",
+                        "name": "rankBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ranking sort key of bybit linear and inverse markets (change, funding_rate, open_interest, open_interest_value), or any JSON field of the rows to page by",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Page order: desc (default) or asc; without a field sort, asc reverses the ranking",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of rows to skip; returns a page with the total count",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page; takes precedence over offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated JSON fields to keep in every row",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep perpetuals with a funding rate (fraction) of at least this value",
+                        "name": "minFundingRate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep perpetuals with a funding rate (fraction) of at most this value",
+                        "name": "maxFundingRate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep contracts with an open interest of at least this value in the quote asset",
+                        "name": "minOpenInterestValue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at least this percentage",
+                        "name": "minChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at most this percentage",
+                        "name": "maxChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount",
+                        "name": "minQuoteVolume",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Keep pairs with at least this many trades; applied where the exchange reports trade counts",
+                        "name": "minTradeCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose last price is at least this value",
+                        "name": "minLastPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose bid/ask spread is at most this percentage of the mid price",
+                        "name": "maxSpreadPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Drop dust-priced pairs whose price tick is more than this percentage of the last price",
+                        "name": "maxTickPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression over normalized ticker fields, e.g. change_pct \u003e 5 \u0026\u0026 quote_volume \u003e 1e6; not applied to bybit option rankBy rankings",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1h",
+                            "4h",
+                            "1d",
+                            "7d"
+                        ],
+                        "type": "string",
+                        "description": "Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Indicator condition on the closed candles of every ranked pair, e.g. rsi \u003c 70 \u0026\u0026 close \u003e sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap",
+                        "name": "indicator",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1m",
+                            "3m",
+                            "5m",
+                            "15m",
+                            "30m",
+                            "1h",
+                            "2h",
+                            "4h",
+                            "6h",
+                            "12h",
+                            "1d",
+                            "1w",
+                            "1M"
+                        ],
+                        "type": "string",
+                        "default": "1h",
+                        "description": "Candle interval of the indicator condition",
+                        "name": "indicatorInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side",
+                        "name": "orderSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 50,
+                        "description": "Maximum slippage from the mid price, in basis points, of the orderSize filter",
+                        "name": "maxSlippageBps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200",
+                        "name": "checkLimit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of native ticker data representing top gainers",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "object"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid market type or query parameters"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/{exchange}/ticker/24hr/gainers/pairs": {
+            "get": {
+                "description": "This function fetches trading pairs that have shown price gains over the last 24 hours.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchanges"
+                ],
+                "summary": "Retrieve top gainers in a specified market with filtering options.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit",
+                            "okx",
+                            "kraken",
+                            "coinbase",
+                            "kucoin"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Limit the number of results; default is 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "USDT",
+                        "description": "Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD; default is 'USDT'",
+                        "name": "endingFilter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "BNB",
+                        "description": "Comma-separated values to drop, matched by excludeMode; default is 'BNB'",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "base",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of exclude; default is contains (symbol substring)",
+                        "name": "excludeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to keep, matched by includeMode",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "base",
+                            "contains",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of include; default is base (exact base asset)",
+                        "name": "includeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)",
+                        "name": "excludeLeveraged",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)",
+                        "name": "excludeStablePairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)",
+                        "name": "excludeFiatPairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Underlying of the option market (bybit); defaults to BTC",
+                        "name": "baseCoin",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "volume",
+                            "turnover",
+                            "open_interest",
+                            "iv",
+                            "change"
+                        ],
+                        "type": "string",
+                        "default": "volume",
+                        "description": "Ranking key of the option market (bybit); default is volume",
+                        "name": "rankBy",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "change",
+                            "funding_rate",
+                            "open_interest",
+                            "open_interest_value"
+                        ],
+                        "type": "string",
+                        "description": "Ranking sort key of bybit linear and inverse markets; pair lists are not paged, so other fields return 400",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep perpetuals with a funding rate (fraction) of at least this value",
+                        "name": "minFundingRate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep perpetuals with a funding rate (fraction) of at most this value",
+                        "name": "maxFundingRate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep contracts with an open interest of at least this value in the quote asset",
+                        "name": "minOpenInterestValue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at least this percentage",
+                        "name": "minChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at most this percentage",
+                        "name": "maxChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount",
+                        "name": "minQuoteVolume",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Keep pairs with at least this many trades; applied where the exchange reports trade counts",
+                        "name": "minTradeCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose last price is at least this value",
+                        "name": "minLastPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose bid/ask spread is at most this percentage of the mid price",
+                        "name": "maxSpreadPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Drop dust-priced pairs whose price tick is more than this percentage of the last price",
+                        "name": "maxTickPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression over normalized ticker fields, e.g. change_pct \u003e 5 \u0026\u0026 quote_volume \u003e 1e6; not applied to bybit option rankBy rankings",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1h",
+                            "4h",
+                            "1d",
+                            "7d"
+                        ],
+                        "type": "string",
+                        "description": "Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Indicator condition on the closed candles of every ranked pair, e.g. rsi \u003c 70 \u0026\u0026 close \u003e sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap",
+                        "name": "indicator",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1m",
+                            "3m",
+                            "5m",
+                            "15m",
+                            "30m",
+                            "1h",
+                            "2h",
+                            "4h",
+                            "6h",
+                            "12h",
+                            "1d",
+                            "1w",
+                            "1M"
+                        ],
+                        "type": "string",
+                        "default": "1h",
+                        "description": "Candle interval of the indicator condition",
+                        "name": "indicatorInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side",
+                        "name": "orderSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 50,
+                        "description": "Maximum slippage from the mid price, in basis points, of the orderSize filter",
+                        "name": "maxSlippageBps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200",
+                        "name": "checkLimit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PairListResponse representing top gainers",
+                        "schema": {
+                            "$ref": "#/definitions/handler.PairListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid market type or query parameters"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/{exchange}/ticker/24hr/losers": {
+            "get": {
+                "description": "This function fetches trading pairs that have lost value over the last 24 hours, largest loss first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchanges"
+                ],
+                "summary": "Retrieve top losers in a specified market.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit",
+                            "okx",
+                            "kraken",
+                            "coinbase",
+                            "kucoin"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 500,
+                        "description": "Limit the number of results; default is 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD",
+                        "name": "endingFilter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to drop, matched by excludeMode",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "base",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of exclude; default is contains (symbol substring)",
+                        "name": "excludeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to keep, matched by includeMode",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "base",
+                            "contains",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of include; default is base (exact base asset)",
+                        "name": "includeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)",
+                        "name": "excludeLeveraged",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)",
+                        "name": "excludeStablePairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)",
+                        "name": "excludeFiatPairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Underlying of the option market (bybit); defaults to BTC",
+                        "name": "baseCoin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ranking sort key of bybit linear and inverse markets (change, funding_rate, open_interest, open_interest_value), or any JSON field of the rows to page by",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Page order: desc (default) or asc; without a field sort, asc reverses the ranking",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of rows to skip; returns a page with the total count",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page; takes precedence over offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated JSON fields to keep in every row",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at least this percentage",
+                        "name": "minChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at most this percentage",
+                        "name": "maxChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount",
+                        "name": "minQuoteVolume",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Keep pairs with at least this many trades; applied where the exchange reports trade counts",
+                        "name": "minTradeCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose last price is at least this value",
+                        "name": "minLastPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose bid/ask spread is at most this percentage of the mid price",
+                        "name": "maxSpreadPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Drop dust-priced pairs whose price tick is more than this percentage of the last price",
+                        "name": "maxTickPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression over normalized ticker fields, e.g. change_pct \u003e 5 \u0026\u0026 quote_volume \u003e 1e6",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1h",
+                            "4h",
+                            "1d",
+                            "7d"
+                        ],
+                        "type": "string",
+                        "description": "Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Indicator condition on the closed candles of every ranked pair, e.g. rsi \u003c 70 \u0026\u0026 close \u003e sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap",
+                        "name": "indicator",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1m",
+                            "3m",
+                            "5m",
+                            "15m",
+                            "30m",
+                            "1h",
+                            "2h",
+                            "4h",
+                            "6h",
+                            "12h",
+                            "1d",
+                            "1w",
+                            "1M"
+                        ],
+                        "type": "string",
+                        "default": "1h",
+                        "description": "Candle interval of the indicator condition",
+                        "name": "indicatorInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side",
+                        "name": "orderSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 50,
+                        "description": "Maximum slippage from the mid price, in basis points, of the orderSize filter",
+                        "name": "maxSlippageBps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200",
+                        "name": "checkLimit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of native ticker data representing top losers",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "object"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid market type or query parameters"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/{exchange}/ticker/24hr/losers/pairs": {
+            "get": {
+                "description": "This function returns the trading pairs that have lost value over the last 24 hours, largest loss first, in the Freqtrade remote pair list format.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchanges"
+                ],
+                "summary": "Retrieve top losers in a specified market as a pair list.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit",
+                            "okx",
+                            "kraken",
+                            "coinbase",
+                            "kucoin"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Limit the number of results; default is 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "USDT",
+                        "description": "Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD; default is 'USDT'",
+                        "name": "endingFilter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "BNB",
+                        "description": "Comma-separated values to drop, matched by excludeMode; default is 'BNB'",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "base",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of exclude; default is contains (symbol substring)",
+                        "name": "excludeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to keep, matched by includeMode",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "base",
+                            "contains",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of include; default is base (exact base asset)",
+                        "name": "includeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)",
+                        "name": "excludeLeveraged",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)",
+                        "name": "excludeStablePairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)",
+                        "name": "excludeFiatPairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Underlying of the option market (bybit); defaults to BTC",
+                        "name": "baseCoin",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "change",
+                            "funding_rate",
+                            "open_interest",
+                            "open_interest_value"
+                        ],
+                        "type": "string",
+                        "description": "Ranking sort key of bybit linear and inverse markets; pair lists are not paged, so other fields return 400",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at least this percentage",
+                        "name": "minChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at most this percentage",
+                        "name": "maxChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount",
+                        "name": "minQuoteVolume",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Keep pairs with at least this many trades; applied where the exchange reports trade counts",
+                        "name": "minTradeCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose last price is at least this value",
+                        "name": "minLastPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose bid/ask spread is at most this percentage of the mid price",
+                        "name": "maxSpreadPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Drop dust-priced pairs whose price tick is more than this percentage of the last price",
+                        "name": "maxTickPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression over normalized ticker fields, e.g. change_pct \u003e 5 \u0026\u0026 quote_volume \u003e 1e6",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1h",
+                            "4h",
+                            "1d",
+                            "7d"
+                        ],
+                        "type": "string",
+                        "description": "Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Indicator condition on the closed candles of every ranked pair, e.g. rsi \u003c 70 \u0026\u0026 close \u003e sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap",
+                        "name": "indicator",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1m",
+                            "3m",
+                            "5m",
+                            "15m",
+                            "30m",
+                            "1h",
+                            "2h",
+                            "4h",
+                            "6h",
+                            "12h",
+                            "1d",
+                            "1w",
+                            "1M"
+                        ],
+                        "type": "string",
+                        "default": "1h",
+                        "description": "Candle interval of the indicator condition",
+                        "name": "indicatorInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side",
+                        "name": "orderSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 50,
+                        "description": "Maximum slippage from the mid price, in basis points, of the orderSize filter",
+                        "name": "maxSlippageBps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200",
+                        "name": "checkLimit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PairListResponse representing top losers",
+                        "schema": {
+                            "$ref": "#/definitions/handler.PairListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid market type or query parameters"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/{exchange}/ticker/24hr/volatile": {
+            "get": {
+                "description": "This function fetches trading pairs ranked by their 24-hour range, (high - low) / open, widest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchanges"
+                ],
+                "summary": "Retrieve the most volatile trading pairs in a specified market.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit",
+                            "okx",
+                            "kraken",
+                            "coinbase",
+                            "kucoin"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 500,
+                        "description": "Limit the number of results; default is 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD",
+                        "name": "endingFilter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to drop, matched by excludeMode",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "base",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of exclude; default is contains (symbol substring)",
+                        "name": "excludeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to keep, matched by includeMode",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "base",
+                            "contains",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of include; default is base (exact base asset)",
+                        "name": "includeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)",
+                        "name": "excludeLeveraged",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)",
+                        "name": "excludeStablePairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)",
+                        "name": "excludeFiatPairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Underlying of the option market (bybit); defaults to BTC",
+                        "name": "baseCoin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ranking sort key of bybit linear and inverse markets (change, funding_rate, open_interest, open_interest_value), or any JSON field of the rows to page by",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Page order: desc (default) or asc; without a field sort, asc reverses the ranking",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of rows to skip; returns a page with the total count",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page; takes precedence over offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated JSON fields to keep in every row",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at least this percentage",
+                        "name": "minChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at most this percentage",
+                        "name": "maxChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount",
+                        "name": "minQuoteVolume",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Keep pairs with at least this many trades; applied where the exchange reports trade counts",
+                        "name": "minTradeCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose last price is at least this value",
+                        "name": "minLastPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose bid/ask spread is at most this percentage of the mid price",
+                        "name": "maxSpreadPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Drop dust-priced pairs whose price tick is more than this percentage of the last price",
+                        "name": "maxTickPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression over normalized ticker fields, e.g. change_pct \u003e 5 \u0026\u0026 quote_volume \u003e 1e6",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1h",
+                            "4h",
+                            "1d",
+                            "7d"
+                        ],
+                        "type": "string",
+                        "description": "Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Indicator condition on the closed candles of every ranked pair, e.g. rsi \u003c 70 \u0026\u0026 close \u003e sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap",
+                        "name": "indicator",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1m",
+                            "3m",
+                            "5m",
+                            "15m",
+                            "30m",
+                            "1h",
+                            "2h",
+                            "4h",
+                            "6h",
+                            "12h",
+                            "1d",
+                            "1w",
+                            "1M"
+                        ],
+                        "type": "string",
+                        "default": "1h",
+                        "description": "Candle interval of the indicator condition",
+                        "name": "indicatorInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side",
+                        "name": "orderSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 50,
+                        "description": "Maximum slippage from the mid price, in basis points, of the orderSize filter",
+                        "name": "maxSlippageBps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200",
+                        "name": "checkLimit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of native ticker data, widest range first",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "object"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid market type or query parameters"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/{exchange}/ticker/24hr/volatile/pairs": {
+            "get": {
+                "description": "This function returns the trading pairs ranked by their 24-hour (high - low) / open range, widest first, in the Freqtrade remote pair list format.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchanges"
+                ],
+                "summary": "Retrieve the most volatile trading pairs as a pair list.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit",
+                            "okx",
+                            "kraken",
+                            "coinbase",
+                            "kucoin"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Limit the number of results; default is 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "USDT",
+                        "description": "Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD; default is 'USDT'",
+                        "name": "endingFilter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "BNB",
+                        "description": "Comma-separated values to drop, matched by excludeMode; default is 'BNB'",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "base",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of exclude; default is contains (symbol substring)",
+                        "name": "excludeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to keep, matched by includeMode",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "base",
+                            "contains",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of include; default is base (exact base asset)",
+                        "name": "includeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)",
+                        "name": "excludeLeveraged",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)",
+                        "name": "excludeStablePairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)",
+                        "name": "excludeFiatPairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Underlying of the option market (bybit); defaults to BTC",
+                        "name": "baseCoin",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "change",
+                            "funding_rate",
+                            "open_interest",
+                            "open_interest_value"
+                        ],
+                        "type": "string",
+                        "description": "Ranking sort key of bybit linear and inverse markets; pair lists are not paged, so other fields return 400",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at least this percentage",
+                        "name": "minChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at most this percentage",
+                        "name": "maxChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount",
+                        "name": "minQuoteVolume",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Keep pairs with at least this many trades; applied where the exchange reports trade counts",
+                        "name": "minTradeCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose last price is at least this value",
+                        "name": "minLastPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose bid/ask spread is at most this percentage of the mid price",
+                        "name": "maxSpreadPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Drop dust-priced pairs whose price tick is more than this percentage of the last price",
+                        "name": "maxTickPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression over normalized ticker fields, e.g. change_pct \u003e 5 \u0026\u0026 quote_volume \u003e 1e6",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1h",
+                            "4h",
+                            "1d",
+                            "7d"
+                        ],
+                        "type": "string",
+                        "description": "Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Indicator condition on the closed candles of every ranked pair, e.g. rsi \u003c 70 \u0026\u0026 close \u003e sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap",
+                        "name": "indicator",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1m",
+                            "3m",
+                            "5m",
+                            "15m",
+                            "30m",
+                            "1h",
+                            "2h",
+                            "4h",
+                            "6h",
+                            "12h",
+                            "1d",
+                            "1w",
+                            "1M"
+                        ],
+                        "type": "string",
+                        "default": "1h",
+                        "description": "Candle interval of the indicator condition",
+                        "name": "indicatorInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side",
+                        "name": "orderSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 50,
+                        "description": "Maximum slippage from the mid price, in basis points, of the orderSize filter",
+                        "name": "maxSlippageBps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200",
+                        "name": "checkLimit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PairListResponse, widest range first",
+                        "schema": {
+                            "$ref": "#/definitions/handler.PairListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid market type or query parameters"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/{exchange}/ticker/24hr/volume": {
+            "get": {
+                "description": "This function fetches trading pairs ranked by their 24-hour quote volume (turnover), largest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchanges"
+                ],
+                "summary": "Retrieve the top trading pairs by quote volume in a specified market.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit",
+                            "okx",
+                            "kraken",
+                            "coinbase",
+                            "kucoin"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 500,
+                        "description": "Limit the number of results; default is 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD",
+                        "name": "endingFilter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to drop, matched by excludeMode",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "base",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of exclude; default is contains (symbol substring)",
+                        "name": "excludeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to keep, matched by includeMode",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "base",
+                            "contains",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of include; default is base (exact base asset)",
+                        "name": "includeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)",
+                        "name": "excludeLeveraged",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)",
+                        "name": "excludeStablePairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)",
+                        "name": "excludeFiatPairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Underlying of the option market (bybit); defaults to BTC",
+                        "name": "baseCoin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ranking sort key of bybit linear and inverse markets (change, funding_rate, open_interest, open_interest_value), or any JSON field of the rows to page by",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Page order: desc (default) or asc; without a field sort, asc reverses the ranking",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of rows to skip; returns a page with the total count",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page; takes precedence over offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated JSON fields to keep in every row",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at least this percentage",
+                        "name": "minChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at most this percentage",
+                        "name": "maxChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount",
+                        "name": "minQuoteVolume",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Keep pairs with at least this many trades; applied where the exchange reports trade counts",
+                        "name": "minTradeCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose last price is at least this value",
+                        "name": "minLastPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose bid/ask spread is at most this percentage of the mid price",
+                        "name": "maxSpreadPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Drop dust-priced pairs whose price tick is more than this percentage of the last price",
+                        "name": "maxTickPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression over normalized ticker fields, e.g. change_pct \u003e 5 \u0026\u0026 quote_volume \u003e 1e6",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1h",
+                            "4h",
+                            "1d",
+                            "7d"
+                        ],
+                        "type": "string",
+                        "description": "Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Indicator condition on the closed candles of every ranked pair, e.g. rsi \u003c 70 \u0026\u0026 close \u003e sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap",
+                        "name": "indicator",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1m",
+                            "3m",
+                            "5m",
+                            "15m",
+                            "30m",
+                            "1h",
+                            "2h",
+                            "4h",
+                            "6h",
+                            "12h",
+                            "1d",
+                            "1w",
+                            "1M"
+                        ],
+                        "type": "string",
+                        "default": "1h",
+                        "description": "Candle interval of the indicator condition",
+                        "name": "indicatorInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side",
+                        "name": "orderSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 50,
+                        "description": "Maximum slippage from the mid price, in basis points, of the orderSize filter",
+                        "name": "maxSlippageBps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200",
+                        "name": "checkLimit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of native ticker data, largest quote volume first",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "object"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid market type or query parameters"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/{exchange}/ticker/24hr/volume/pairs": {
+            "get": {
+                "description": "This function returns the trading pairs ranked by their USD-equivalent 24-hour quote volume, largest first, in the Freqtrade remote pair list format.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchanges"
+                ],
+                "summary": "Retrieve the top trading pairs by quote volume as a pair list.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit",
+                            "okx",
+                            "kraken",
+                            "coinbase",
+                            "kucoin"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Limit the number of results; default is 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "USDT",
+                        "description": "Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD; default is 'USDT'",
+                        "name": "endingFilter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "BNB",
+                        "description": "Comma-separated values to drop, matched by excludeMode; default is 'BNB'",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "contains",
+                            "base",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of exclude; default is contains (symbol substring)",
+                        "name": "excludeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated values to keep, matched by includeMode",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "base",
+                            "contains",
+                            "prefix",
+                            "suffix",
+                            "regex"
+                        ],
+                        "type": "string",
+                        "description": "Match mode of include; default is base (exact base asset)",
+                        "name": "includeMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)",
+                        "name": "excludeLeveraged",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)",
+                        "name": "excludeStablePairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)",
+                        "name": "excludeFiatPairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Underlying of the option market (bybit); defaults to BTC",
+                        "name": "baseCoin",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "change",
+                            "funding_rate",
+                            "open_interest",
+                            "open_interest_value"
+                        ],
+                        "type": "string",
+                        "description": "Ranking sort key of bybit linear and inverse markets; pair lists are not paged, so other fields return 400",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at least this percentage",
+                        "name": "minChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour change is at most this percentage",
+                        "name": "maxChangePercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount",
+                        "name": "minQuoteVolume",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Keep pairs with at least this many trades; applied where the exchange reports trade counts",
+                        "name": "minTradeCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose last price is at least this value",
+                        "name": "minLastPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Keep pairs whose bid/ask spread is at most this percentage of the mid price",
+                        "name": "maxSpreadPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Drop dust-priced pairs whose price tick is more than this percentage of the last price",
+                        "name": "maxTickPercent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression over normalized ticker fields, e.g. change_pct \u003e 5 \u0026\u0026 quote_volume \u003e 1e6",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1h",
+                            "4h",
+                            "1d",
+                            "7d"
+                        ],
+                        "type": "string",
+                        "description": "Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Indicator condition on the closed candles of every ranked pair, e.g. rsi \u003c 70 \u0026\u0026 close \u003e sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap",
+                        "name": "indicator",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "1m",
+                            "3m",
+                            "5m",
+                            "15m",
+                            "30m",
+                            "1h",
+                            "2h",
+                            "4h",
+                            "6h",
+                            "12h",
+                            "1d",
+                            "1w",
+                            "1M"
+                        ],
+                        "type": "string",
+                        "default": "1h",
+                        "description": "Candle interval of the indicator condition",
+                        "name": "indicatorInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side",
+                        "name": "orderSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 50,
+                        "description": "Maximum slippage from the mid price, in basis points, of the orderSize filter",
+                        "name": "maxSlippageBps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200",
+                        "name": "checkLimit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PairListResponse, largest quote volume first",
+                        "schema": {
+                            "$ref": "#/definitions/handler.PairListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid market type or query parameters"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/{exchange}/ticker/24hr/{pair}": {
+            "get": {
+                "description": "This function fetches the latest ticker information for a specified trading pair in a chosen market of the exchange.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchanges"
+                ],
+                "summary": "Retrieve ticker data for a specific trading pair.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit",
+                            "okx",
+                            "kraken",
+                            "coinbase",
+                            "kucoin"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading pair symbol (e.g., BTCUSDT)",
+                        "name": "pair",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot",
+                        "name": "market",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Native ticker data for the specified pair",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid market type"
+                    },
+                    "404": {
+                        "description": "Trading pair symbol is not listed in the market"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/{exchange}/trades/{pair}": {
+            "get": {
+                "description": "This function returns the most recent trades of a trading pair, oldest first, with the quote volume bought and sold by takers, their buy/sell ratio, the number of trades per minute and the largest trades by quote quantity. The metrics cover the window from the first to the last returned trade.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchanges"
+                ],
+                "summary": "Retrieve the recent trades and taker flow of a trading pair.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "binance",
+                            "bybit"
+                        ],
+                        "type": "string",
+                        "description": "Exchange name",
+                        "name": "exchange",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading pair symbol (e.g., BTCUSDT)",
+                        "name": "pair",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse",
+                        "name": "market",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 500,
+                        "description": "Number of recent trades; default is 500, capped by the exchange",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Aggregate trades by taker order and price; binance spot and linear only",
+                        "name": "aggregate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Number of largest trades to report; default is 5",
+                        "name": "largest",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recent trades with taker flow metrics",
+                        "schema": {
+                            "$ref": "#/definitions/common.TradeReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid market type, limit, aggregate or largest"
+                    },
+                    "404": {
+                        "description": "Trading pair symbol is not listed in the market"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "common.Candle": {
+            "type": "object",
+            "properties": {
+                "close": {
+                    "type": "number"
                 },
-                "lastId": {
+                "high": {
                     "type": "number"
                 },
-                "lastPrice": {
-                    "type": "string"
+                "low": {
+                    "type": "number"
                 },
-                "lastQty": {
-                    "type": "string"
+                "open": {
+                    "type": "number"
                 },
-                "lowPrice": {
+                "open_time": {
                     "type": "string"
                 },
-                "openPrice": {
-                    "type": "string"
+                "quote_volume": {
+                    "type": "number"
+                },
+                "volume": {
+                    "type": "number"
+                }
+            }
+        },
+        "common.Category": {
+            "type": "string",
+            "enum": [
+                "leveraged",
+                "stable_pair",
+                "fiat_pair"
+            ],
+            "x-enum-varnames": [
+                "CategoryLeveraged",
+                "CategoryStablePair",
+                "CategoryFiatPair"
+            ]
+        },
+        "common.Depth": {
+            "type": "object",
+            "properties": {
+                "ask": {
+                    "type": "number"
                 },
-                "openTime": {
+                "bid": {
                     "type": "number"
                 },
-                "prevClosePrice": {
+                "percent": {
+                    "type": "number"
+                }
+            }
+        },
+        "common.DepthReport": {
+            "type": "object",
+            "properties": {
+                "asks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.Level"
+                    }
+                },
+                "bids": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.Level"
+                    }
+                },
+                "exchange": {
+                    "type": "string"
+                },
+                "market": {
                     "type": "string"
                 },
-                "priceChange": {
+                "metrics": {
+                    "$ref": "#/definitions/common.Liquidity"
+                },
+                "symbol": {
                     "type": "string"
                 },
-                "priceChangePercent": {
+                "time": {
                     "type": "string"
+                }
+            }
+        },
+        "common.Interval": {
+            "type": "string",
+            "enum": [
+                "1m",
+                "3m",
+                "5m",
+                "15m",
+                "30m",
+                "1h",
+                "2h",
+                "4h",
+                "6h",
+                "12h",
+                "1d",
+                "1w",
+                "1M",
+                "1h"
+            ],
+            "x-enum-varnames": [
+                "Interval1m",
+                "Interval3m",
+                "Interval5m",
+                "Interval15m",
+                "Interval30m",
+                "Interval1h",
+                "Interval2h",
+                "Interval4h",
+                "Interval6h",
+                "Interval12h",
+                "Interval1d",
+                "Interval1w",
+                "Interval1M",
+                "DefaultCandleInterval"
+            ]
+        },
+        "common.Level": {
+            "type": "object",
+            "properties": {
+                "price": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                }
+            }
+        },
+        "common.Liquidity": {
+            "type": "object",
+            "properties": {
+                "depth": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.Depth"
+                    }
+                },
+                "mid": {
+                    "type": "number"
+                },
+                "slippage": {
+                    "$ref": "#/definitions/common.Slippage"
+                },
+                "spread_bps": {
+                    "type": "number"
+                }
+            }
+        },
+        "common.Quote": {
+            "type": "object",
+            "properties": {
+                "ask_price": {
+                    "type": "number"
                 },
-                "priceChangePercentFloat": {
+                "bid_price": {
                     "type": "number"
                 },
-                "quoteVolume": {
+                "exchange": {
                     "type": "string"
                 },
+                "last_price": {
+                    "type": "number"
+                },
                 "symbol": {
                     "type": "string"
+                }
+            }
+        },
+        "common.Slippage": {
+            "type": "object",
+            "properties": {
+                "buy_bps": {
+                    "type": "number"
+                },
+                "buy_price": {
+                    "type": "number"
+                },
+                "notional": {
+                    "type": "number"
+                },
+                "sell_bps": {
+                    "type": "number"
+                },
+                "sell_price": {
+                    "type": "number"
+                }
+            }
+        },
+        "common.Spread": {
+            "type": "object",
+            "properties": {
+                "arbitrage_percent": {
+                    "type": "number"
+                },
+                "buy_exchange": {
+                    "type": "string"
                 },
-                "volume": {
+                "first": {
+                    "$ref": "#/definitions/common.Quote"
+                },
+                "pair": {
                     "type": "string"
                 },
-                "weightedAvgPrice": {
+                "second": {
+                    "$ref": "#/definitions/common.Quote"
+                },
+                "sell_exchange": {
                     "type": "string"
+                },
+                "spread_percent": {
+                    "type": "number"
                 }
             }
         },
-        "handler.PairListResponse": {
+        "common.SymbolInfo": {
             "type": "object",
             "properties": {
-                "pairs": {
+                "base_asset": {
+                    "type": "string"
+                },
+                "categories": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/common.Category"
                     }
                 },
-                "refresh_period": {
-                    "type": "integer"
+                "contract_size": {
+                    "description": "ContractSize is the quote value of one contract on markets sized in contracts of a\nfixed quote value, e.g. Binance COIN-M; zero elsewhere.",
+                    "type": "number"
+                },
+                "lot_size": {
+                    "type": "number"
+                },
+                "quote_asset": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "tick_size": {
+                    "type": "number"
+                },
+                "trading": {
+                    "type": "boolean"
                 }
             }
         },
-        "handler.Ticker": {
+        "common.Symbols": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#/definitions/common.SymbolInfo"
+            }
+        },
+        "common.Ticker": {
             "type": "object",
             "properties": {
-                "askPrice": {
-                    "type": "string"
+                "ask_price": {
+                    "type": "number"
                 },
-                "askQty": {
+                "ask_qty": {
+                    "type": "number"
+                },
+                "base_asset": {
                     "type": "string"
                 },
-                "bidPrice": {
+                "basis": {
+                    "type": "number"
+                },
+                "bid_price": {
+                    "type": "number"
+                },
+                "bid_qty": {
+                    "type": "number"
+                },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.Category"
+                    }
+                },
+                "change_percent": {
+                    "type": "number"
+                },
+                "close_time": {
                     "type": "string"
                 },
-                "bidQty": {
+                "exchange": {
                     "type": "string"
                 },
-                "closeTime": {
+                "funding_rate": {
                     "type": "number"
                 },
-                "count": {
-                    "type": "integer"
+                "high_price": {
+                    "type": "number"
+                },
+                "index_price": {
+                    "type": "number"
+                },
+                "last_price": {
+                    "type": "number"
+                },
+                "low_price": {
+                    "type": "number"
                 },
-                "firstId": {
+                "mark_price": {
                     "type": "number"
                 },
-                "highPrice": {
+                "market": {
+                    "type": "string"
+                },
+                "next_funding_time": {
                     "type": "string"
                 },
-                "lastId": {
+                "open_interest": {
+                    "type": "number"
+                },
+                "open_interest_value": {
+                    "type": "number"
+                },
+                "open_price": {
                     "type": "number"
                 },
-                "lastPrice": {
+                "open_time": {
                     "type": "string"
                 },
-                "lastQty": {
+                "quote_asset": {
                     "type": "string"
                 },
-                "lowPrice": {
+                "quote_volume": {
+                    "type": "number"
+                },
+                "symbol": {
                     "type": "string"
                 },
-                "openPrice": {
+                "tick_size": {
+                    "type": "number"
+                },
+                "trade_count": {
+                    "type": "integer"
+                },
+                "volume": {
+                    "type": "number"
+                }
+            }
+        },
+        "common.Trade": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string"
                 },
-                "openTime": {
+                "price": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "quote_quantity": {
                     "type": "number"
                 },
-                "prevClosePrice": {
+                "side": {
+                    "type": "string"
+                },
+                "time": {
                     "type": "string"
+                }
+            }
+        },
+        "common.TradeFlow": {
+            "type": "object",
+            "properties": {
+                "buy_sell_ratio": {
+                    "type": "number"
+                },
+                "buy_volume": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
                 },
-                "priceChange": {
+                "from": {
                     "type": "string"
                 },
-                "priceChangePercent": {
+                "largest": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.Trade"
+                    }
+                },
+                "sell_volume": {
+                    "type": "number"
+                },
+                "to": {
                     "type": "string"
                 },
-                "priceChangePercentFloat": {
+                "trades_per_minute": {
                     "type": "number"
+                }
+            }
+        },
+        "common.TradeReport": {
+            "type": "object",
+            "properties": {
+                "exchange": {
+                    "type": "string"
                 },
-                "quoteVolume": {
+                "market": {
                     "type": "string"
                 },
+                "metrics": {
+                    "$ref": "#/definitions/common.TradeFlow"
+                },
                 "symbol": {
                     "type": "string"
                 },
-                "volume": {
+                "trades": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.Trade"
+                    }
+                }
+            }
+        },
+        "handler.AggregatedGainers": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "tickers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.Ticker"
+                    }
+                }
+            }
+        },
+        "handler.PairListResponse": {
+            "type": "object",
+            "properties": {
+                "pairs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "refresh_period": {
+                    "type": "integer"
+                }
+            }
+        },
+        "indicators.Snapshot": {
+            "type": "object",
+            "properties": {
+                "close": {
+                    "type": "number"
+                },
+                "indicators": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "interval": {
+                    "$ref": "#/definitions/common.Interval"
+                },
+                "open_time": {
                     "type": "string"
                 },
-                "weightedAvgPrice": {
+                "symbol": {
                     "type": "string"
                 }
             }
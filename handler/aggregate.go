@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type Aggregate interface {
+	Get24HourGainersTickerData(c *gin.Context)
+	GetSpreads(c *gin.Context)
+}
+
+type AggregateImpl struct {
+	parser parser.Parser
+}
+
+type AggregatedGainers parser.AggregatedGainers
+type Spreads []common.Spread
+
+// Get24HourGainersTickerData retrieves the top gainers across all configured exchanges.
+//
+//	@Summary		Retrieve top gainers ranked across every configured exchange.
+//	@Description	This function fetches 24-hour tickers from the spot and derivative markets of every configured exchange, normalizes them and returns one ranked list. Option markets are only ranked when requested with market.
+//
+//	Each row carries its source exchange and market. Sources that fail, or fail for some pairs, are reported in the errors map while the rest are still ranked.
+//	Kraken reports no rolling 24-hour open, so its change is measured from the open at UTC midnight (see open_time of the normalized rows).
+//
+//	@Produce		json
+//	@Tags			Aggregate
+//	@Param			limit				query		int					false	"Limit the number of results; default is 500"	default(500)
+//	@Param			endingFilter		query		string				false	"Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD"
+//	@Param			exclude				query		string				false	"Comma-separated values to drop, matched by excludeMode"
+//	@Param			excludeMode			query		string				false	"Match mode of exclude; default is contains (symbol substring)"	Enums(contains, base, prefix, suffix, regex)
+//	@Param			include				query		string				false	"Comma-separated values to keep, matched by includeMode"
+//	@Param			includeMode			query		string				false	"Match mode of include; default is base (exact base asset)"					Enums(base, contains, prefix, suffix, regex)
+//	@Param			excludeLeveraged	query		bool				false	"Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)"						default(false)
+//	@Param			excludeStablePairs	query		bool				false	"Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)"	default(false)
+//	@Param			excludeFiatPairs	query		bool				false	"Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)"		default(false)
+//	@Param			exchange			query		string				false	"Comma-separated list of exchanges to include; default is all"
+//	@Param			market				query		string				false	"Comma-separated market types to include, e.g. spot,linear; default is every market except option"
+//	@Param			minChangePercent	query		number				false	"Keep pairs whose 24-hour change is at least this percentage"
+//	@Param			maxChangePercent	query		number				false	"Keep pairs whose 24-hour change is at most this percentage"
+//	@Param			minQuoteVolume		query		number				false	"Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount"
+//	@Param			minTradeCount		query		int					false	"Keep pairs with at least this many trades; applied where the exchange reports trade counts"
+//	@Param			minLastPrice		query		number				false	"Keep pairs whose last price is at least this value"
+//	@Param			maxSpreadPercent	query		number				false	"Keep pairs whose bid/ask spread is at most this percentage of the mid price"
+//	@Param			maxTickPercent		query		number				false	"Drop dust-priced pairs whose price tick is more than this percentage of the last price"
+//	@Param			filter				query		string				false	"Filter expression over normalized ticker fields, e.g. change_pct > 5 && quote_volume > 1e6"
+//	@Success		200					{object}	AggregatedGainers	"Ranked list of normalized tickers representing top gainers"
+//	@Failure		400					"Unknown exchange or market, invalid limit, threshold or filter"
+//	@Failure		500					"Internal Server Error"
+//	@Router			/gainers [get]
+func (h *AggregateImpl) Get24HourGainersTickerData(c *gin.Context) {
+	names, ok := h.exchanges(c)
+	if !ok {
+		return
+	}
+	markets, ok := h.markets(c, names)
+	if !ok {
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "500"))
+	if err != nil || limit < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return
+	}
+	query := common.GainersQuery{
+		Limit:         limit,
+		EndingFilter:  c.DefaultQuery("endingFilter", ""),
+		ExcludeFilter: c.DefaultQuery("exclude", ""),
+	}
+	if !thresholdsQuery(c, &query, h.parser.Thresholds()) || !matchQuery(c, &query) || !categoryQuery(c, &query) || !filterQuery(c, &query) {
+		return
+	}
+
+	gainers, err := h.parser.AggregateGainers(names, markets, query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gainers)
+}
+
+// GetSpreads compares the prices of every pair listed on two exchanges.
+//
+//	@Summary		Retrieve the cross-exchange price spread of every common pair.
+//	@Description	This function fetches 24-hour tickers of the same market on two exchanges and, for every pair listed on both, returns the last price and best bid/ask on each venue with the percentage spread between them.
+//
+//	Results are sorted by the largest absolute spread first. The arbitrage percentage is the best return of buying at the ask on one venue and selling at the bid on the other, before fees.
+//	Pairs that could not be fetched on one exchange are reported in the X-Partial-Errors header, keyed by exchange/symbol.
+//
+//	@Produce		json
+//	@Tags			Aggregate
+//	@Param			first	query		string	false	"First exchange; default is binance"						default(binance)
+//	@Param			second	query		string	false	"Second exchange; default is bybit"							default(bybit)
+//	@Param			market	query		string	false	"Market type supported by both exchanges; default is spot"	default(spot)
+//	@Param			limit	query		int		false	"Limit the number of results; 0 returns every pair"			default(0)
+//	@Success		200		{object}	Spreads	"List of spreads, largest gap first"
+//	@Failure		400		"Unknown exchange, unsupported market type or invalid limit"
+//	@Failure		500		"Internal Server Error"
+//	@Router			/spread [get]
+func (h *AggregateImpl) GetSpreads(c *gin.Context) {
+	first := c.DefaultQuery("first", "binance")
+	second := c.DefaultQuery("second", "bybit")
+	market := c.DefaultQuery("market", "spot")
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return
+	}
+
+	for _, name := range []string{first, second} {
+		exchange, ok := h.parser.Exchange(name)
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown exchange: " + name})
+			return
+		}
+		if !supportsMarket(exchange, market) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid market type"})
+			return
+		}
+	}
+
+	spreads, err := h.parser.Spreads(first, second, market)
+	if err = partialErrors(c, err); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if limit > 0 && limit < len(spreads) {
+		spreads = spreads[:limit]
+	}
+	c.JSON(http.StatusOK, spreads)
+}
+
+// exchanges reads the comma-separated exchange query parameter and checks every name is configured.
+// It writes a 400 response and returns false when an exchange is unknown.
+func (h *AggregateImpl) exchanges(c *gin.Context) ([]string, bool) {
+	var names []string
+	for _, name := range strings.Split(c.DefaultQuery("exchange", ""), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := h.parser.Exchange(name); !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown exchange: " + name})
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, true
+}
+
+// markets reads the comma-separated market query parameter and checks every market is
+// supported by at least one of the named exchanges, or of every exchange when no name is
+// given. It writes a 400 response and returns false when a market is unsupported.
+func (h *AggregateImpl) markets(c *gin.Context, names []string) ([]string, bool) {
+	exchanges := h.parser.Exchanges()
+	if len(names) > 0 {
+		exchanges = exchanges[:0:0]
+		for _, name := range names {
+			exchange, _ := h.parser.Exchange(name)
+			exchanges = append(exchanges, exchange)
+		}
+	}
+
+	var markets []string
+	for _, market := range strings.Split(c.DefaultQuery("market", ""), ",") {
+		market = strings.TrimSpace(market)
+		if market == "" {
+			continue
+		}
+		supported := false
+		for _, exchange := range exchanges {
+			supported = supported || supportsMarket(exchange, market)
+		}
+		if !supported {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid market type: " + market})
+			return nil, false
+		}
+		markets = append(markets, market)
+	}
+	return markets, true
+}
+
+func NewAggregate(parser2 parser.Parser) *AggregateImpl {
+	return &AggregateImpl{parser: parser2}
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// GetCandles retrieves historical candles (klines) of a trading pair.
+//
+//	@Summary		Retrieve historical OHLCV candles of a trading pair.
+//	@Description	This function returns the candles of a trading pair in the exchange-agnostic common.Candle format, oldest first.
+//
+//	Without startTime, the latest limit candles up to endTime (default now) are returned; with startTime, the first limit candles from startTime.
+//	Requests for more candles than the exchange returns at once (1000) are paged through upstream.
+//	Times are Unix milliseconds or RFC 3339.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange	path	string			true	"Exchange name"	Enums(binance, bybit)
+//	@Param			pair		path	string			true	"Trading pair symbol (e.g., BTCUSDT)"
+//	@Param			market		query	string			false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, inverse"
+//	@Param			interval	query	string			false	"Candle interval"	Enums(1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h, 1d, 1w, 1M)	default(1h)
+//	@Param			startTime	query	string			false	"Open time of the first candle, in Unix milliseconds or RFC 3339"
+//	@Param			endTime		query	string			false	"Latest open time of the last candle, in Unix milliseconds or RFC 3339"
+//	@Param			limit		query	int				false	"Number of candles; default is 500, at most 10000"	default(500)
+//	@Success		200			{array}	common.Candle	"Candles, oldest first"
+//	@Failure		400			"Invalid market type, interval, time or limit"
+//	@Failure		404			"Trading pair symbol is not listed in the market"
+//	@Failure		500			"Internal Server Error"
+//	@Router			/{exchange}/klines/{pair} [get]
+func (h *ExchangeImpl) GetCandles(c *gin.Context) {
+	exchange, ok := h.exchange.(parser.CandleExchange)
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Candles are not supported on this exchange"})
+		return
+	}
+	market, ok := h.market(c)
+	if !ok {
+		return
+	}
+	query, ok := candleQuery(c)
+	if !ok {
+		return
+	}
+
+	candles, err := exchange.Candles(market, c.Param("pair"), query)
+	if err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, candles)
+}
+
+// candleQuery reads the interval, startTime, endTime and limit query parameters of a
+// candle request. It writes a 400 response and returns false when one is invalid.
+func candleQuery(c *gin.Context) (common.CandleQuery, bool) {
+	query := common.CandleQuery{
+		Interval: common.Interval(c.DefaultQuery("interval", string(common.DefaultCandleInterval))),
+	}
+	if !common.IsValidInterval(query.Interval) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid interval"})
+		return query, false
+	}
+
+	var ok bool
+	if query.Start, ok = timeQuery(c, "startTime"); !ok {
+		return query, false
+	}
+	if query.End, ok = timeQuery(c, "endTime"); !ok {
+		return query, false
+	}
+
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit <= 0 || limit > common.MaxCandleLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+			return query, false
+		}
+		query.Limit = limit
+	}
+	return query, true
+}
+
+// timeQuery reads a time query parameter given in Unix milliseconds or RFC 3339. It writes
+// a 400 response and returns false when the value is neither; a missing value is zero.
+func timeQuery(c *gin.Context, name string) (time.Time, bool) {
+	raw := c.Query(name)
+	if raw == "" {
+		return time.Time{}, true
+	}
+	if millis, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return time.UnixMilli(millis).UTC(), true
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + name})
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// Depth filter defaults, the most order book requests in flight while checking the depth
+// of ranked pairs, and the levels per side they request. 100 levels is the cheapest
+// Binance depth request weight tier, and covers a maxSlippageBps of a few percent on
+// liquid pairs.
+const (
+	defaultMaxSlippageBps = 50
+	depthConcurrency      = 8
+	depthCheckLevels      = 100
+)
+
+// GetDepth retrieves the order book of a trading pair with its liquidity metrics.
+//
+//	@Summary		Retrieve the order book and liquidity metrics of a trading pair.
+//	@Description	This function returns the order book of a trading pair, best levels first, with its mid price, spread in basis points and the quote notional resting within 0.5%, 1% and 2% of the mid price on each side.
+//
+//	With notional, it also estimates the average fill price and slippage from the mid price, in basis points, of a market buy and sell of that size in the quote asset; values are null when the book is too thin.
+//	Metrics only cover the returned levels: binance returns up to 5000 (spot) or 1000 (futures) per side, bybit up to 200 (spot), 500 (linear, inverse) or 25 (option).
+//	Inverse books are sized in contracts of a fixed USD value, so their quantities are converted to the base asset: bybit contracts are worth 1 USD, binance COIN-M contracts their contract size.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange	path		string				true	"Exchange name"	Enums(binance, bybit)
+//	@Param			pair		path		string				true	"Trading pair symbol (e.g., BTCUSDT)"
+//	@Param			market		query		string				false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse"
+//	@Param			limit		query		int					false	"Levels per side; default is 500, capped by the exchange"	default(500)
+//	@Param			notional	query		number				false	"Order size in the quote asset to estimate slippage for"
+//	@Success		200			{object}	common.DepthReport	"Order book with liquidity metrics"
+//	@Failure		400			"Invalid market type, limit or notional"
+//	@Failure		404			"Trading pair symbol is not listed in the market"
+//	@Failure		500			"Internal Server Error"
+//	@Router			/{exchange}/depth/{pair} [get]
+func (h *ExchangeImpl) GetDepth(c *gin.Context) {
+	exchange, ok := h.exchange.(parser.DepthExchange)
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Order books are not supported on this exchange"})
+		return
+	}
+	market, ok := h.market(c)
+	if !ok {
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(common.DefaultDepthLimit)))
+	if err != nil || limit <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return
+	}
+	notional, err := strconv.ParseFloat(c.DefaultQuery("notional", "0"), 64)
+	if err != nil || notional < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid notional"})
+		return
+	}
+
+	book, err := exchange.OrderBook(market, c.Param("pair"), limit)
+	if err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, common.DepthReport{OrderBook: book, Metrics: book.Analyze(notional)})
+}
+
+// depthQuery adds a check to the gainers query that keeps the ranked pairs on which a
+// buy and a sell of orderSize in the quote asset fill within maxSlippageBps of the mid
+// price. It writes a 400 response and returns false when a parameter is invalid or the
+// exchange serves no order books. The number of pairs checked is bounded by checkLimit;
+// see checkQuery. The returned function reports the pairs whose order books could not be
+// fetched once the ranking is done.
+func (h *ExchangeImpl) depthQuery(c *gin.Context, query *common.GainersQuery, market string) (func() error, bool) {
+	none := func() error { return nil }
+	raw := c.Query("orderSize")
+	if raw == "" {
+		return none, true
+	}
+
+	exchange, ok := h.exchange.(parser.DepthExchange)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Depth filters are not supported on this exchange"})
+		return none, false
+	}
+	orderSize, err := strconv.ParseFloat(raw, 64)
+	if err != nil || orderSize <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid orderSize"})
+		return none, false
+	}
+	maxSlippage, err := strconv.ParseFloat(c.DefaultQuery("maxSlippageBps", strconv.Itoa(defaultMaxSlippageBps)), 64)
+	if err != nil || maxSlippage < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid maxSlippageBps"})
+		return none, false
+	}
+
+	check, failed := common.CheckEach(depthConcurrency, func(t common.Ticker) (bool, error) {
+		book, err := exchange.OrderBook(market, t.Symbol, depthCheckLevels)
+		if err != nil {
+			return false, err
+		}
+		return book.Fills(orderSize, maxSlippage), nil
+	})
+	query.Check = common.ChainChecks(query.Check, check)
+	return failed, true
+}
@@ -0,0 +1,572 @@
+package handler
+
+import (
+	"errors"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/filter"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type PairListResponse = common.PairListResponse
+
+type Exchange interface {
+	Get24HourTickerData(c *gin.Context)
+	GetTickerForPair(c *gin.Context)
+	Get24HourGainersTickerData(c *gin.Context)
+	Get24HourGainersPairs(c *gin.Context)
+	Get24HourLosersTickerData(c *gin.Context)
+	Get24HourLosersPairs(c *gin.Context)
+	Get24HourVolumeTickerData(c *gin.Context)
+	Get24HourVolumePairs(c *gin.Context)
+	Get24HourVolatileTickerData(c *gin.Context)
+	Get24HourVolatilePairs(c *gin.Context)
+	GetSymbols(c *gin.Context)
+	GetCandles(c *gin.Context)
+	GetIndicators(c *gin.Context)
+	GetDepth(c *gin.Context)
+	GetTrades(c *gin.Context)
+}
+
+type ExchangeImpl struct {
+	exchange   parser.Exchange
+	thresholds common.Thresholds
+}
+
+// Get24HourTickerData retrieves 24-hour ticker data for a specified market type.
+//
+//	@Summary		Retrieve 24-hour ticker data for all trading pairs of an exchange.
+//	@Description	This function fetches the 24-hour ticker data for all trading pairs in a given market of the exchange.
+//
+//	The market type can be specified as a query parameter; if not provided, the exchange's default market is used.
+//	The payload is returned in the exchange's native ticker format unless normalized=true is given.
+//	Bybit option tickers are listed per baseCoin and include the mark IV and Greeks.
+//	Paging parameters (limit, offset, cursor, sort, order, fields) return a page {total, offset, limit, next_cursor, data} instead of the full list.
+//	A comma-separated symbols list returns {tickers, errors} for just those pairs from one bulk request, with an error for every pair the market does not list; paging parameters are ignored.
+//	Pairs that could not be fetched, e.g. Coinbase products whose stats failed, are left out of the list and reported in the X-Partial-Errors header (a JSON object keyed by symbol) and in the errors field of pages.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange	path	string	true	"Exchange name"	Enums(binance, bybit, okx, kraken, coinbase, kucoin)
+//	@Param			market		query	string	false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot"
+//	@Param			baseCoin	query	string	false	"Underlying of the option market on exchanges that list options per base coin (bybit); defaults to BTC"
+//	@Param			normalized	query	bool	false	"Return exchange-agnostic common.Ticker rows instead of the native format"	default(false)
+//	@Param			symbols		query	string	false	"Comma-separated trading pairs to return, e.g. BTCUSDT,ETHUSDT; native tickers require binance or bybit"
+//	@Param			limit		query	int		false	"Page size; default is 100"
+//	@Param			offset		query	int		false	"Number of rows to skip"
+//	@Param			cursor		query	string	false	"next_cursor of the previous page; takes precedence over offset"
+//	@Param			sort		query	string	false	"JSON field of the rows to sort by, e.g. quoteVolume or change_percent with normalized=true"
+//	@Param			order		query	string	false	"Sort order: desc (default) or asc; without sort, asc reverses the list"	Enums(asc, desc)
+//	@Param			fields		query	string	false	"Comma-separated JSON fields to keep in every row"
+//	@Success		200			{array}	object	"List of native or normalized ticker data for each trading pair"
+//	@Failure		400			"Invalid market type, normalized, symbols or paging parameters"
+//	@Failure		500			"Internal Server Error"
+//	@Router			/{exchange}/ticker/24hr [get]
+func (h *ExchangeImpl) Get24HourTickerData(c *gin.Context) {
+	market, ok := h.market(c)
+	if !ok {
+		return
+	}
+
+	normalized, err := strconv.ParseBool(c.DefaultQuery("normalized", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid normalized"})
+		return
+	}
+	if value, ok := c.GetQuery("symbols"); ok {
+		symbols := common.UniqueSymbols(strings.Split(value, ","))
+		if len(symbols) == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid symbols"})
+			return
+		}
+		h.tickersFor(c, market, symbols, normalized)
+		return
+	}
+
+	page, paged, ok := pageQuery(c, false, common.DefaultPageLimit)
+	if !ok {
+		return
+	}
+
+	var tickerData interface{}
+	options, isOption := h.options(market)
+	switch {
+	case isOption && normalized:
+		tickerData, err = options.NormalizedOptionTickers(c.Query("baseCoin"))
+	case isOption:
+		tickerData, err = options.OptionTickers(c.Query("baseCoin"))
+	case normalized:
+		tickerData, err = h.exchange.NormalizedTickers(market)
+	default:
+		tickerData, err = h.exchange.Tickers(market)
+	}
+	if err = partialErrors(c, err); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	writeList(c, tickerData, page, paged)
+}
+
+// GetTickerForPair retrieves ticker data for a specified trading pair in a given market.
+//
+//	@Summary		Retrieve ticker data for a specific trading pair.
+//	@Description	This function fetches the latest ticker information for a specified trading pair in a chosen market of the exchange.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange	path		string	true	"Exchange name"	Enums(binance, bybit, okx, kraken, coinbase, kucoin)
+//	@Param			pair		path		string	true	"Trading pair symbol (e.g., BTCUSDT)"
+//	@Param			market		query		string	false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot"
+//	@Success		200			{object}	object	"Native ticker data for the specified pair"
+//	@Failure		400			"Invalid market type"
+//	@Failure		404			"Trading pair symbol is not listed in the market"
+//	@Failure		500			"Internal Server Error"
+//	@Router			/{exchange}/ticker/24hr/{pair} [get]
+func (h *ExchangeImpl) GetTickerForPair(c *gin.Context) {
+	market, ok := h.market(c)
+	if !ok {
+		return
+	}
+
+	pair := c.Param("pair")
+	ticker, err := h.exchange.Ticker(market, pair)
+	if err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, ticker)
+}
+
+// Get24HourGainersTickerData retrieves a list of top gaining trading pairs over the last 24 hours.
+//
+//	@Summary		Retrieve top gainers in a specified market with an optional limit and ending filter.
+//	@Description	This function fetches trading pairs that have shown price gains over the last 24 hours in a specified market of the exchange.
+//
+//	It allows filtering by a specific market type and a limit on the number of results. An optional ending filter can also be applied to refine the results.
+//	In the Bybit option market, the options of baseCoin are ranked by rankBy instead of by price change.
+//	In the Bybit linear and inverse markets, gainers can be sorted and filtered by funding rate and open interest.
+//	Kraken reports no rolling 24-hour open, so its change is measured from the open at UTC midnight (see open_time of the normalized rows).
+//	Coinbase products whose stats could not be fetched are left out of the ranking and reported in the X-Partial-Errors header.
+//	Change, volume, trade count, price and spread thresholds apply to every market except options.
+//
+//	Paging parameters (offset, cursor, order, fields, or a sort by a row field) return a page {total, offset, limit, next_cursor, data} of the whole ranking, with limit as the page size.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange				path	string	true	"Exchange name"									Enums(binance, bybit, okx, kraken, coinbase, kucoin)
+//	@Param			limit					query	int		false	"Limit the number of results; default is 500"	default(500)
+//	@Param			endingFilter			query	string	false	"Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD"
+//	@Param			exclude					query	string	false	"Comma-separated values to drop, matched by excludeMode"
+//	@Param			excludeMode				query	string	false	"Match mode of exclude; default is contains (symbol substring)"	Enums(contains, base, prefix, suffix, regex)
+//	@Param			include					query	string	false	"Comma-separated values to keep, matched by includeMode"
+//	@Param			includeMode				query	string	false	"Match mode of include; default is base (exact base asset)"					Enums(base, contains, prefix, suffix, regex)
+//	@Param			excludeLeveraged		query	bool	false	"Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)"						default(false)
+//	@Param			excludeStablePairs		query	bool	false	"Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)"	default(false)
+//	@Param			excludeFiatPairs		query	bool	false	"Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)"		default(false)
+//	@Param			market					query	string	false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot"
+//	@Param			baseCoin				query	string	false	"Underlying of the option market (bybit); defaults to BTC"
+//	@Param			rankBy					query	string	false	"Ranking key of the option market (bybit); default is volume"	Enums(volume, turnover, open_interest, iv, change)	default(volume)
+//	@Param			sort					query	string	false	"Ranking sort key of bybit linear and inverse markets (change, funding_rate, open_interest, open_interest_value), or any JSON field of the rows to page by"
+//	@Param			order					query	string	false	"Page order: desc (default) or asc; without a field sort, asc reverses the ranking"	Enums(asc, desc)
+//	@Param			offset					query	int		false	"Number of rows to skip; returns a page with the total count"
+//	@Param			cursor					query	string	false	"next_cursor of the previous page; takes precedence over offset"
+//	@Param			fields					query	string	false	"Comma-separated JSON fields to keep in every row"
+//	@Param			minFundingRate			query	number	false	"Keep perpetuals with a funding rate (fraction) of at least this value"
+//	@Param			maxFundingRate			query	number	false	"Keep perpetuals with a funding rate (fraction) of at most this value"
+//	@Param			minOpenInterestValue	query	number	false	"Keep contracts with an open interest of at least this value in the quote asset"
+//	@Param			minChangePercent		query	number	false	"Keep pairs whose 24-hour change is at least this percentage"
+//	@Param			maxChangePercent		query	number	false	"Keep pairs whose 24-hour change is at most this percentage"
+//	@Param			minQuoteVolume			query	number	false	"Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount"
+//	@Param			minTradeCount			query	int		false	"Keep pairs with at least this many trades; applied where the exchange reports trade counts"
+//	@Param			minLastPrice			query	number	false	"Keep pairs whose last price is at least this value"
+//	@Param			maxSpreadPercent		query	number	false	"Keep pairs whose bid/ask spread is at most this percentage of the mid price"
+//	@Param			maxTickPercent			query	number	false	"Drop dust-priced pairs whose price tick is more than this percentage of the last price"
+//	@Param			filter					query	string	false	"Filter expression over normalized ticker fields, e.g. change_pct > 5 && quote_volume > 1e6; not applied to bybit option rankBy rankings"
+//	@Param			window					query	string	false	"Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse"	Enums(1h, 4h, 1d, 7d)
+//	@Param			indicator				query	string	false	"Indicator condition on the closed candles of every ranked pair, e.g. rsi < 70 && close > sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap"
+//	@Param			indicatorInterval		query	string	false	"Candle interval of the indicator condition"	Enums(1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h, 1d, 1w, 1M)	default(1h)
+//	@Param			orderSize				query	number	false	"Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side"
+//	@Param			maxSlippageBps			query	number	false	"Maximum slippage from the mid price, in basis points, of the orderSize filter"																		default(50)
+//	@Param			checkLimit				query	int		false	"Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200"	default(50)
+//	@Success		200						{array}	object	"List of native ticker data representing top gainers"
+//	@Failure		400						"Invalid market type or query parameters"
+//	@Failure		500						"Internal Server Error"
+//	@Router			/{exchange}/ticker/24hr/gainers [get]
+func (h *ExchangeImpl) Get24HourGainersTickerData(c *gin.Context) {
+	h.rank(c, common.RankingGainers)
+}
+
+// Get24HourGainersPairs retrieves a list of top gaining trading pairs over the last 24 hours.
+//
+//	@Summary		Retrieve top gainers in a specified market with filtering options.
+//	@Description	This function fetches trading pairs that have shown price gains over the last 24 hours.
+//
+//	It allows filtering by a specific market type, a limit on the number of results, and options to include
+//	or exclude pairs based on their symbol. In the Bybit option market, the options of baseCoin are ranked by
+//	rankBy and listed by their contract symbol; the ending filter does not apply. In the Bybit linear and inverse
+//	markets, gainers can be sorted and filtered by funding rate and open interest. Change, volume, trade count,
+//	price and spread thresholds apply to every market except options.
+//	Kraken reports no rolling 24-hour open, so its change is measured from the open at UTC midnight.
+//	Coinbase products whose stats could not be fetched are left out and reported in the X-Partial-Errors header.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange				path		string				true	"Exchange name"																	Enums(binance, bybit, okx, kraken, coinbase, kucoin)
+//	@Param			limit					query		int					false	"Limit the number of results; default is 100"									default(100)
+//	@Param			endingFilter			query		string				false	"Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD; default is 'USDT'"	default(USDT)
+//	@Param			exclude					query		string				false	"Comma-separated values to drop, matched by excludeMode; default is 'BNB'"		default(BNB)
+//	@Param			excludeMode				query		string				false	"Match mode of exclude; default is contains (symbol substring)"					Enums(contains, base, prefix, suffix, regex)
+//	@Param			include					query		string				false	"Comma-separated values to keep, matched by includeMode"
+//	@Param			includeMode				query		string				false	"Match mode of include; default is base (exact base asset)"					Enums(base, contains, prefix, suffix, regex)
+//	@Param			excludeLeveraged		query		bool				false	"Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)"						default(false)
+//	@Param			excludeStablePairs		query		bool				false	"Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)"	default(false)
+//	@Param			excludeFiatPairs		query		bool				false	"Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)"		default(false)
+//	@Param			market					query		string				false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot"
+//	@Param			baseCoin				query		string				false	"Underlying of the option market (bybit); defaults to BTC"
+//	@Param			rankBy					query		string				false	"Ranking key of the option market (bybit); default is volume"													Enums(volume, turnover, open_interest, iv, change)	default(volume)
+//	@Param			sort					query		string				false	"Ranking sort key of bybit linear and inverse markets; pair lists are not paged, so other fields return 400"	Enums(change, funding_rate, open_interest, open_interest_value)
+//	@Param			minFundingRate			query		number				false	"Keep perpetuals with a funding rate (fraction) of at least this value"
+//	@Param			maxFundingRate			query		number				false	"Keep perpetuals with a funding rate (fraction) of at most this value"
+//	@Param			minOpenInterestValue	query		number				false	"Keep contracts with an open interest of at least this value in the quote asset"
+//	@Param			minChangePercent		query		number				false	"Keep pairs whose 24-hour change is at least this percentage"
+//	@Param			maxChangePercent		query		number				false	"Keep pairs whose 24-hour change is at most this percentage"
+//	@Param			minQuoteVolume			query		number				false	"Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount"
+//	@Param			minTradeCount			query		int					false	"Keep pairs with at least this many trades; applied where the exchange reports trade counts"
+//	@Param			minLastPrice			query		number				false	"Keep pairs whose last price is at least this value"
+//	@Param			maxSpreadPercent		query		number				false	"Keep pairs whose bid/ask spread is at most this percentage of the mid price"
+//	@Param			maxTickPercent			query		number				false	"Drop dust-priced pairs whose price tick is more than this percentage of the last price"
+//	@Param			filter					query		string				false	"Filter expression over normalized ticker fields, e.g. change_pct > 5 && quote_volume > 1e6; not applied to bybit option rankBy rankings"
+//	@Param			window					query		string				false	"Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse"	Enums(1h, 4h, 1d, 7d)
+//	@Param			indicator				query		string				false	"Indicator condition on the closed candles of every ranked pair, e.g. rsi < 70 && close > sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap"
+//	@Param			indicatorInterval		query		string				false	"Candle interval of the indicator condition"	Enums(1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h, 1d, 1w, 1M)	default(1h)
+//	@Param			orderSize				query		number				false	"Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side"
+//	@Param			maxSlippageBps			query		number				false	"Maximum slippage from the mid price, in basis points, of the orderSize filter"																		default(50)
+//	@Param			checkLimit				query		int					false	"Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200"	default(50)
+//	@Success		200						{object}	PairListResponse	"PairListResponse representing top gainers"
+//	@Failure		400						"Invalid market type or query parameters"
+//	@Failure		500						"Internal Server Error"
+//	@Router			/{exchange}/ticker/24hr/gainers/pairs [get]
+func (h *ExchangeImpl) Get24HourGainersPairs(c *gin.Context) {
+	h.rankPairs(c, common.RankingGainers)
+}
+
+// GetSymbols retrieves the metadata of every symbol in a market.
+//
+//	@Summary		Retrieve symbol metadata.
+//	@Description	This function returns the base asset, quote asset, status, tick size and lot size of every symbol in a market of the exchange.
+//
+//	Metadata is loaded from the exchange (e.g. Binance exchangeInfo, Bybit instruments-info) and cached in memory.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange	path		string			true	"Exchange name"	Enums(binance, bybit, okx, kraken, coinbase, kucoin)
+//	@Param			market		query		string			false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot"
+//	@Success		200			{object}	common.Symbols	"Symbol metadata keyed by symbol"
+//	@Failure		400			"Invalid market type"
+//	@Failure		500			"Internal Server Error"
+//	@Router			/{exchange}/symbols [get]
+func (h *ExchangeImpl) GetSymbols(c *gin.Context) {
+	market, ok := h.market(c)
+	if !ok {
+		return
+	}
+
+	symbols, err := h.exchange.Symbols(market)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, symbols)
+}
+
+// market reads the market query parameter and validates it against the markets of the exchange.
+// It writes a 400 response and returns false when the market is not supported.
+func (h *ExchangeImpl) market(c *gin.Context) (string, bool) {
+	market := c.DefaultQuery("market", h.exchange.Markets()[0])
+	if !supportsMarket(h.exchange, market) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid market type"})
+		return "", false
+	}
+	return market, true
+}
+
+// tickersFor writes the tickers of the requested symbols of the market as a
+// common.TickerBatch, with an error for every symbol the market does not list. Native
+// tickers come from one bulk request of a parser.BatchExchange; normalized tickers are
+// picked from the normalized ticker list.
+func (h *ExchangeImpl) tickersFor(c *gin.Context, market string, symbols []string, normalized bool) {
+	var batch common.TickerBatch
+	if normalized {
+		var tickers []common.Ticker
+		var err error
+		if options, isOption := h.options(market); isOption {
+			tickers, err = options.NormalizedOptionTickers(c.Query("baseCoin"))
+		} else {
+			tickers, err = h.exchange.NormalizedTickers(market)
+		}
+		var partial *common.PartialError
+		errors.As(err, &partial)
+		if err = partialErrors(c, err); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		batch.Tickers, batch.Errors = common.PickSymbols(tickers, func(t common.Ticker) string { return t.Symbol }, symbols)
+		// Symbols that could not be fetched are not unknown; report their fetch error.
+		for i, symbolErr := range batch.Errors {
+			if partial != nil && partial.Errors[symbolErr.Symbol] != "" {
+				batch.Errors[i].Error = partial.Errors[symbolErr.Symbol]
+			}
+		}
+		c.JSON(http.StatusOK, batch)
+		return
+	}
+
+	exchange, ok := h.exchange.(parser.BatchExchange)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Multi-symbol requests are only supported with normalized=true on this exchange"})
+		return
+	}
+	var err error
+	batch.Tickers, batch.Errors, err = exchange.TickersFor(market, symbols)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, batch)
+}
+
+// options returns the exchange as an OptionExchange when the market is its option market.
+func (h *ExchangeImpl) options(market string) (parser.OptionExchange, bool) {
+	options, ok := h.exchange.(parser.OptionExchange)
+	if !ok || market != options.OptionMarket() {
+		return nil, false
+	}
+	return options, true
+}
+
+// optionsQuery reads the baseCoin and rankBy query parameters of the option ranking.
+// It writes a 400 response and returns false when the ranking key is not supported.
+func optionsQuery(c *gin.Context, limit int) (common.OptionsQuery, bool) {
+	rankBy := c.DefaultQuery("rankBy", common.DefaultOptionRank)
+	if !common.IsValidOptionRank(rankBy) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid rankBy"})
+		return common.OptionsQuery{}, false
+	}
+	return common.OptionsQuery{
+		BaseCoin: c.Query("baseCoin"),
+		RankBy:   rankBy,
+		Limit:    limit,
+	}, true
+}
+
+// derivativeQuery reads the ranking sort key and the funding rate and open interest filters
+// into the gainers query. Other sort values are page sort fields read by pageQuery. It writes
+// a 400 response and returns false when a parameter is invalid.
+func derivativeQuery(c *gin.Context, query *common.GainersQuery) bool {
+	if sortBy := c.Query("sort"); common.IsValidSortBy(sortBy) {
+		query.SortBy = sortBy
+	}
+
+	var ok bool
+	if query.MinFundingRate, ok = optionalFloat(c, "minFundingRate"); !ok {
+		return false
+	}
+	if query.MaxFundingRate, ok = optionalFloat(c, "maxFundingRate"); !ok {
+		return false
+	}
+	minOpenInterestValue, ok := optionalFloat(c, "minOpenInterestValue")
+	if !ok {
+		return false
+	}
+	if minOpenInterestValue != nil {
+		query.MinOpenInterestValue = *minOpenInterestValue
+	}
+	return true
+}
+
+// thresholdsQuery reads the change and liquidity thresholds into the gainers query, taking
+// every bound the request leaves unset from defaults; an explicit 0 clears a default. It
+// writes a 400 response and returns false when a parameter is not a number.
+func thresholdsQuery(c *gin.Context, query *common.GainersQuery, defaults common.Thresholds) bool {
+	query.Thresholds = defaults
+	for _, bound := range []struct {
+		name  string
+		value *float64
+	}{
+		{"minChangePercent", &query.MinChangePercent},
+		{"maxChangePercent", &query.MaxChangePercent},
+		{"minQuoteVolume", &query.MinQuoteVolume},
+		{"minLastPrice", &query.MinLastPrice},
+		{"maxSpreadPercent", &query.MaxSpreadPercent},
+		{"maxTickPercent", &query.MaxTickPercent},
+	} {
+		value, ok := optionalFloat(c, bound.name)
+		if !ok {
+			return false
+		}
+		if value != nil {
+			*bound.value = *value
+		}
+	}
+
+	if raw := c.Query("minTradeCount"); raw != "" {
+		count, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid minTradeCount"})
+			return false
+		}
+		query.MinTradeCount = count
+	}
+	return true
+}
+
+// matchQuery reads the include list and the include and exclude match modes into the
+// gainers query. It writes a 400 response and returns false when a mode or regular
+// expression is invalid.
+func matchQuery(c *gin.Context, query *common.GainersQuery) bool {
+	query.IncludeFilter = c.Query("include")
+	query.IncludeMode = common.MatchMode(c.Query("includeMode"))
+	query.ExcludeMode = common.MatchMode(c.Query("excludeMode"))
+
+	for _, list := range []struct {
+		name     string
+		mode     common.MatchMode
+		value    string
+		fallback common.MatchMode
+	}{
+		{"include", query.IncludeMode, query.IncludeFilter, common.DefaultIncludeMode},
+		{"exclude", query.ExcludeMode, query.ExcludeFilter, common.DefaultExcludeMode},
+	} {
+		if !common.IsValidMatchMode(list.mode) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + list.name + "Mode"})
+			return false
+		}
+		if _, err := common.NewMatcher(list.mode, list.value, list.fallback); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + list.name + ": " + err.Error()})
+			return false
+		}
+	}
+	return true
+}
+
+// categoryQuery reads the flags dropping leveraged tokens, stablecoin pairs and fiat pairs
+// into the gainers query. It writes a 400 response and returns false when a flag is not a boolean.
+func categoryQuery(c *gin.Context, query *common.GainersQuery) bool {
+	for _, flag := range []struct {
+		name  string
+		value *bool
+	}{
+		{"excludeLeveraged", &query.ExcludeLeveraged},
+		{"excludeStablePairs", &query.ExcludeStablePairs},
+		{"excludeFiatPairs", &query.ExcludeFiatPairs},
+	} {
+		raw := c.Query(flag.name)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + flag.name})
+			return false
+		}
+		*flag.value = value
+	}
+	return true
+}
+
+// windowQuery reads the rolling window of the rankings of the market. It writes a 400
+// response and returns false when the window is invalid or the exchange cannot rank the
+// market over it.
+func (h *ExchangeImpl) windowQuery(c *gin.Context, market string) (common.Window, bool) {
+	window := common.Window(c.Query("window"))
+	if window == "" {
+		return "", true
+	}
+	if !common.IsValidWindow(window) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid window"})
+		return "", false
+	}
+	exchange, ok := h.exchange.(parser.WindowExchange)
+	if !ok || !exchange.SupportsWindow(market, window) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Window " + string(window) + " is not supported in this market"})
+		return "", false
+	}
+	return window, true
+}
+
+// filterQuery parses the filter expression into the gainers query. It writes a 400 response
+// locating the offending token and returns false when the expression is invalid.
+func filterQuery(c *gin.Context, query *common.GainersQuery) bool {
+	expression := c.Query("filter")
+	if expression == "" {
+		return true
+	}
+
+	program, err := common.ParseFilter(expression)
+	if err != nil {
+		response := gin.H{"error": "Invalid filter: " + err.Error()}
+		var filterErr *filter.Error
+		if errors.As(err, &filterErr) {
+			response["position"] = filterErr.Pos
+			response["token"] = filterErr.Token
+		}
+		c.JSON(http.StatusBadRequest, response)
+		return false
+	}
+	query.Filter = program
+	return true
+}
+
+// limitQuery parses the limit query parameter, defaulting to defaultLimit. It writes a
+// 400 response and returns false when the value is not a non-negative integer.
+func limitQuery(c *gin.Context, defaultLimit int) (int, bool) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultLimit)))
+	if err != nil || limit < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return 0, false
+	}
+	return limit, true
+}
+
+// optionalFloat parses the named query parameter, returning nil when it is absent.
+// It writes a 400 response and returns false when the value is not a number.
+func optionalFloat(c *gin.Context, name string) (*float64, bool) {
+	raw, ok := c.GetQuery(name)
+	if !ok || raw == "" {
+		return nil, true
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + name})
+		return nil, false
+	}
+	return &value, true
+}
+
+// supportsMarket reports whether the exchange serves the given market type.
+func supportsMarket(exchange parser.Exchange, market string) bool {
+	for _, m := range exchange.Markets() {
+		if m == market {
+			return true
+		}
+	}
+	return false
+}
+
+// errorStatus maps a parser error to the HTTP status of the response.
+func errorStatus(err error) int {
+	if errors.Is(err, common.ErrUnknownSymbol) {
+		return http.StatusNotFound
+	}
+	if errors.Is(err, common.ErrUnsupportedAggregate) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func NewExchange(exchange parser.Exchange, thresholds common.Thresholds) *ExchangeImpl {
+	return &ExchangeImpl{exchange: exchange, thresholds: thresholds}
+}
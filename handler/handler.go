@@ -5,8 +5,8 @@ import (
 )
 
 type Handlers interface {
-	Binance() Binance
-	Bybit() Bybit
+	Exchange(exchange parser.Exchange) Exchange
+	Aggregate() Aggregate
 }
 
 type HandlersImpl struct {
@@ -17,10 +17,10 @@ func New(parser2 parser.Parser) *HandlersImpl {
 	return &HandlersImpl{parser: parser2}
 }
 
-func (h *HandlersImpl) Binance() Binance {
-	return NewBinance(h.parser)
+func (h *HandlersImpl) Exchange(exchange parser.Exchange) Exchange {
+	return NewExchange(exchange, h.parser.Thresholds())
 }
 
-func (h *HandlersImpl) Bybit() Bybit {
-	return NewBybit(h.parser)
+func (h *HandlersImpl) Aggregate() Aggregate {
+	return NewAggregate(h.parser)
 }
@@ -0,0 +1,443 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// fakeExchange is a parser.Exchange serving fixed normalized tickers. It records the
+// last gainers query, so that tests can check how the handler parsed the request, and
+// returns err, e.g. a *common.PartialError, alongside the ranked rows.
+type fakeExchange struct {
+	tickers []common.Ticker
+	err     error
+
+	mu    sync.Mutex
+	query common.GainersQuery
+}
+
+func (f *fakeExchange) Name() string { return "fake" }
+
+func (f *fakeExchange) Markets() []string { return []string{"spot", "linear"} }
+
+func (f *fakeExchange) Tickers(market string) (interface{}, error) { return f.tickers, f.err }
+
+func (f *fakeExchange) Ticker(market, pair string) (interface{}, error) {
+	for _, t := range f.tickers {
+		if t.Symbol == pair {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", common.ErrUnknownSymbol, pair)
+}
+
+func (f *fakeExchange) Rank(market string, ranking common.Ranking, query common.GainersQuery) (interface{}, error) {
+	f.mu.Lock()
+	f.query = query
+	f.mu.Unlock()
+	return common.Rank(f.tickers, ranking, query), f.err
+}
+
+func (f *fakeExchange) RankPairs(market string, ranking common.Ranking, query common.GainersQuery) (common.PairListResponse, error) {
+	tickers, err := f.Rank(market, ranking, query)
+	return common.PairList(tickers.([]common.Ticker)), err
+}
+
+func (f *fakeExchange) NormalizedTickers(market string) ([]common.Ticker, error) {
+	return f.tickers, f.err
+}
+
+func (f *fakeExchange) Symbols(market string) (common.Symbols, error) { return common.Symbols{}, nil }
+
+func (f *fakeExchange) lastQuery() common.GainersQuery {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.query
+}
+
+// fakeCandleExchange adds candles to fakeExchange. Every pair has rising closes except
+// failing, whose candle request fails; calls counts the candle requests.
+type fakeCandleExchange struct {
+	*fakeExchange
+	failing string
+
+	calls int
+}
+
+func (f *fakeCandleExchange) Candles(market, pair string, query common.CandleQuery) ([]common.Candle, error) {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	if pair == f.failing {
+		return nil, fmt.Errorf("fetch %s: timeout", pair)
+	}
+	candles := make([]common.Candle, query.Limit)
+	for i := range candles {
+		price := float64(100 + i)
+		candles[i] = common.Candle{Open: price, High: price + 1, Low: price - 1, Close: price, Volume: 10, QuoteVolume: 10 * price}
+	}
+	return candles, nil
+}
+
+// fakeMarketDataExchange adds order books and trades to fakeCandleExchange.
+type fakeMarketDataExchange struct {
+	*fakeCandleExchange
+}
+
+func (f *fakeMarketDataExchange) OrderBook(market, pair string, limit int) (common.OrderBook, error) {
+	if _, err := f.Ticker(market, pair); err != nil {
+		return common.OrderBook{}, err
+	}
+	return common.OrderBook{
+		Exchange: "fake",
+		Market:   market,
+		Symbol:   pair,
+		Bids:     []common.Level{{Price: 99.9, Quantity: 100}},
+		Asks:     []common.Level{{Price: 100.1, Quantity: 100}},
+	}, nil
+}
+
+func (f *fakeMarketDataExchange) Trades(market, pair string, query common.TradeQuery) ([]common.Trade, error) {
+	if _, err := f.Ticker(market, pair); err != nil {
+		return nil, err
+	}
+	return []common.Trade{{ID: "1", Price: 100, Quantity: 1, QuoteQuantity: 100, Side: "buy"}}, nil
+}
+
+func newFakeExchange() *fakeExchange {
+	ticker := func(symbol string, change, quoteVolume float64) common.Ticker {
+		return common.Ticker{
+			Exchange:      "fake",
+			Market:        "spot",
+			Symbol:        symbol,
+			BaseAsset:     symbol[:len(symbol)-4],
+			QuoteAsset:    "USDT",
+			LastPrice:     100,
+			OpenPrice:     100 / (1 + change/100),
+			ChangePercent: change,
+			QuoteVolume:   quoteVolume,
+		}
+	}
+	return &fakeExchange{tickers: []common.Ticker{
+		ticker("BTCUSDT", 5, 1e7),
+		ticker("ETHUSDT", 3, 5e6),
+		ticker("SOLUSDT", 8, 2e6),
+		ticker("XRPUSDT", -2, 3e6),
+		ticker("DOGEUSDT", 1, 1e5),
+	}}
+}
+
+// newRouter registers the routes of the exchange handler under /fake as main does.
+func newRouter(exchange parser.Exchange, thresholds common.Thresholds) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	h := NewExchange(exchange, thresholds)
+	group := router.Group("/fake")
+	group.GET("/ticker/24hr", h.Get24HourTickerData)
+	group.GET("/ticker/24hr/:pair", h.GetTickerForPair)
+	group.GET("/ticker/24hr/gainers", h.Get24HourGainersTickerData)
+	group.GET("/ticker/24hr/gainers/pairs", h.Get24HourGainersPairs)
+	group.GET("/ticker/24hr/losers", h.Get24HourLosersTickerData)
+	group.GET("/klines/:pair", h.GetCandles)
+	group.GET("/indicators/:pair", h.GetIndicators)
+	group.GET("/depth/:pair", h.GetDepth)
+	group.GET("/trades/:pair", h.GetTrades)
+	return router
+}
+
+func get(router *gin.Engine, url string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, url, nil))
+	return recorder
+}
+
+func symbols(t *testing.T, body []byte) []string {
+	var rows []common.Ticker
+	if err := json.Unmarshal(body, &rows); err != nil {
+		t.Fatalf("expected a ticker list, but got %s", body)
+	}
+	result := make([]string, len(rows))
+	for i, row := range rows {
+		result[i] = row.Symbol
+	}
+	return result
+}
+
+func equalSymbols(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGetTicker(t *testing.T) {
+	router := newRouter(newFakeExchange(), common.Thresholds{})
+
+	tests := []struct {
+		url    string
+		status int
+	}{
+		{"/fake/ticker/24hr/BTCUSDT", http.StatusOK},
+		{"/fake/ticker/24hr/BTCUSDT?market=linear", http.StatusOK},
+		{"/fake/ticker/24hr/NOPEUSDT", http.StatusNotFound},
+		{"/fake/ticker/24hr/BTCUSDT?market=option", http.StatusBadRequest},
+		{"/fake/ticker/24hr?normalized=true", http.StatusOK},
+		{"/fake/ticker/24hr?normalized=maybe", http.StatusBadRequest},
+		{"/fake/ticker/24hr?limit=abc", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		if recorder := get(router, tt.url); recorder.Code != tt.status {
+			t.Errorf("%s: expected status %d, but got %d: %s", tt.url, tt.status, recorder.Code, recorder.Body)
+		}
+	}
+}
+
+func TestRankQuery(t *testing.T) {
+	fake := newFakeExchange()
+	router := newRouter(fake, common.Thresholds{MinChangePercent: 2, MinQuoteVolume: 1e6})
+
+	recorder := get(router, "/fake/ticker/24hr/gainers?limit=2&exclude=ETH&excludeMode=base&maxChangePercent=7&baseCoin=ETH")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, but got %d: %s", recorder.Code, recorder.Body)
+	}
+	query := fake.lastQuery()
+	if query.Limit != 2 || query.ExcludeFilter != "ETH" || query.ExcludeMode != common.MatchMode("base") || query.BaseCoin != "ETH" {
+		t.Errorf("expected limit 2, exclude ETH by base and base coin ETH, but got %+v", query)
+	}
+	if query.MinChangePercent != 2 || query.MaxChangePercent != 7 || query.MinQuoteVolume != 1e6 {
+		t.Errorf("expected the default thresholds and a max change of 7, but got %+v", query.Thresholds)
+	}
+	if query.CheckLimit != defaultCheckLimit {
+		t.Errorf("expected check limit %d, but got %d", defaultCheckLimit, query.CheckLimit)
+	}
+	if got, want := symbols(t, recorder.Body.Bytes()), []string{"BTCUSDT"}; !equalSymbols(got, want) {
+		t.Errorf("expected %v, but got %v", want, got)
+	}
+
+	get(router, "/fake/ticker/24hr/gainers?minChangePercent=0&minQuoteVolume=0")
+	if query := fake.lastQuery(); query.MinChangePercent != 0 || query.MinQuoteVolume != 0 {
+		t.Errorf("expected an explicit 0 to clear the defaults, but got %+v", query.Thresholds)
+	}
+
+	get(router, "/fake/ticker/24hr/losers")
+	if query := fake.lastQuery(); query.MinChangePercent != 0 || query.MinQuoteVolume != 1e6 {
+		t.Errorf("expected losers to keep only the liquidity defaults, but got %+v", query.Thresholds)
+	}
+
+	get(router, "/fake/ticker/24hr/gainers/pairs")
+	if query := fake.lastQuery(); query.Limit != 100 || query.EndingFilter != "USDT" || query.ExcludeFilter != "BNB" {
+		t.Errorf("expected the pair list defaults, but got %+v", query)
+	}
+}
+
+func TestRankBadRequest(t *testing.T) {
+	router := newRouter(newFakeExchange(), common.Thresholds{})
+
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{"market", "/fake/ticker/24hr/gainers?market=option"},
+		{"threshold", "/fake/ticker/24hr/gainers?minChangePercent=abc"},
+		{"trade count", "/fake/ticker/24hr/gainers?minTradeCount=1.5"},
+		{"exclude mode", "/fake/ticker/24hr/gainers?exclude=BTC&excludeMode=fuzzy"},
+		{"filter", "/fake/ticker/24hr/gainers?filter=change_pct%20%3E"},
+		{"check limit zero", "/fake/ticker/24hr/gainers?checkLimit=0"},
+		{"check limit above max", "/fake/ticker/24hr/gainers?checkLimit=201"},
+		{"indicator unsupported", "/fake/ticker/24hr/gainers?indicator=rsi%20%3C%2070"},
+		{"order size unsupported", "/fake/ticker/24hr/gainers?orderSize=1000"},
+		{"order", "/fake/ticker/24hr/gainers?order=sideways"},
+		{"cursor", "/fake/ticker/24hr/gainers?cursor=%25%25"},
+		{"offset", "/fake/ticker/24hr/gainers?offset=-1"},
+		{"page limit", "/fake/ticker/24hr/gainers?offset=0&limit=abc"},
+		{"unknown field", "/fake/ticker/24hr/gainers?fields=symbol,nope"},
+		{"limit", "/fake/ticker/24hr/gainers?limit=abc"},
+		{"negative limit", "/fake/ticker/24hr/losers?limit=-1"},
+		{"pairs limit", "/fake/ticker/24hr/gainers/pairs?limit=abc"},
+		{"pairs market", "/fake/ticker/24hr/gainers/pairs?market=option"},
+		{"pairs sort", "/fake/ticker/24hr/gainers/pairs?sort=quote_volume"},
+	}
+
+	for _, tt := range tests {
+		recorder := get(router, tt.url)
+		if recorder.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, but got %d: %s", tt.name, recorder.Code, recorder.Body)
+			continue
+		}
+		var body map[string]interface{}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil || body["error"] == nil {
+			t.Errorf("%s: expected an error message, but got %s", tt.name, recorder.Body)
+		}
+	}
+}
+
+func TestRankPaging(t *testing.T) {
+	router := newRouter(newFakeExchange(), common.Thresholds{})
+
+	recorder := get(router, "/fake/ticker/24hr/gainers?offset=0&limit=2")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, but got %d: %s", recorder.Code, recorder.Body)
+	}
+	var page common.Page
+	if err := json.Unmarshal(recorder.Body.Bytes(), &page); err != nil {
+		t.Fatalf("expected a page, but got %s", recorder.Body)
+	}
+	if page.Total != 4 || page.Offset != 0 || page.Limit != 2 || page.NextCursor == "" {
+		t.Errorf("expected total 4, offset 0, limit 2 and a next cursor, but got %+v", page)
+	}
+	raw, _ := json.Marshal(page.Data)
+	if got, want := symbols(t, raw), []string{"SOLUSDT", "BTCUSDT"}; !equalSymbols(got, want) {
+		t.Errorf("expected %v, but got %v", want, got)
+	}
+
+	recorder = get(router, "/fake/ticker/24hr/gainers?limit=2&cursor="+page.NextCursor)
+	page = common.Page{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &page); err != nil {
+		t.Fatalf("expected a page, but got %s", recorder.Body)
+	}
+	if page.Offset != 2 || page.NextCursor != "" {
+		t.Errorf("expected the last page at offset 2, but got %+v", page)
+	}
+	raw, _ = json.Marshal(page.Data)
+	if got, want := symbols(t, raw), []string{"ETHUSDT", "DOGEUSDT"}; !equalSymbols(got, want) {
+		t.Errorf("expected %v, but got %v", want, got)
+	}
+
+	recorder = get(router, "/fake/ticker/24hr/gainers?sort=quote_volume&order=asc&fields=symbol")
+	page = common.Page{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &page); err != nil {
+		t.Fatalf("expected a page, but got %s", recorder.Body)
+	}
+	raw, _ = json.Marshal(page.Data)
+	if got, want := symbols(t, raw), []string{"DOGEUSDT", "SOLUSDT", "ETHUSDT", "BTCUSDT"}; !equalSymbols(got, want) {
+		t.Errorf("expected %v, but got %v", want, got)
+	}
+}
+
+func TestRankPartialErrors(t *testing.T) {
+	fake := newFakeExchange()
+	fake.err = &common.PartialError{Errors: map[string]string{"ADAUSDT": "stats: timeout"}}
+	router := newRouter(fake, common.Thresholds{})
+
+	recorder := get(router, "/fake/ticker/24hr/gainers")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, but got %d: %s", recorder.Code, recorder.Body)
+	}
+	if header := recorder.Header().Get("X-Partial-Errors"); header != `{"ADAUSDT":"stats: timeout"}` {
+		t.Errorf("expected the failed product in X-Partial-Errors, but got %q", header)
+	}
+	if got := symbols(t, recorder.Body.Bytes()); len(got) != 4 {
+		t.Errorf("expected the 4 fetched gainers, but got %v", got)
+	}
+
+	recorder = get(router, "/fake/ticker/24hr/gainers?offset=2&limit=1")
+	var page common.Page
+	if err := json.Unmarshal(recorder.Body.Bytes(), &page); err != nil {
+		t.Fatalf("expected a page, but got %s", recorder.Body)
+	}
+	if page.Total != 4 || len(page.Data) != 1 || page.NextCursor == "" {
+		t.Errorf("expected 1 of 4 gainers and a next cursor, but got %+v", page)
+	}
+	if page.Errors["ADAUSDT"] != "stats: timeout" {
+		t.Errorf("expected the failed product in the page errors, but got %v", page.Errors)
+	}
+
+	recorder = get(router, "/fake/ticker/24hr/gainers/pairs")
+	if recorder.Code != http.StatusOK || recorder.Header().Get("X-Partial-Errors") == "" {
+		t.Errorf("expected a pair list with X-Partial-Errors, but got %d %q", recorder.Code, recorder.Header().Get("X-Partial-Errors"))
+	}
+
+	fake.err = fmt.Errorf("exchange down")
+	if recorder := get(router, "/fake/ticker/24hr/gainers"); recorder.Code != http.StatusInternalServerError {
+		t.Errorf("expected status 500, but got %d", recorder.Code)
+	}
+}
+
+func TestRankIndicatorCheck(t *testing.T) {
+	fake := &fakeCandleExchange{fakeExchange: newFakeExchange(), failing: "BTCUSDT"}
+	router := newRouter(fake, common.Thresholds{})
+
+	recorder := get(router, "/fake/ticker/24hr/gainers?indicator=close%20%3E%200")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, but got %d: %s", recorder.Code, recorder.Body)
+	}
+	if got, want := symbols(t, recorder.Body.Bytes()), []string{"SOLUSDT", "ETHUSDT", "DOGEUSDT"}; !equalSymbols(got, want) {
+		t.Errorf("expected %v, but got %v", want, got)
+	}
+	var failed map[string]string
+	if err := json.Unmarshal([]byte(recorder.Header().Get("X-Partial-Errors")), &failed); err != nil || failed["BTCUSDT"] == "" {
+		t.Errorf("expected BTCUSDT in X-Partial-Errors, but got %q", recorder.Header().Get("X-Partial-Errors"))
+	}
+	if query := fake.lastQuery(); query.Check == nil {
+		t.Errorf("expected an indicator check on the query")
+	}
+
+	fake.calls = 0
+	recorder = get(router, "/fake/ticker/24hr/gainers?indicator=close%20%3E%200&checkLimit=2")
+	if got, want := symbols(t, recorder.Body.Bytes()), []string{"SOLUSDT"}; !equalSymbols(got, want) {
+		t.Errorf("expected %v, but got %v", want, got)
+	}
+	if fake.calls != 2 {
+		t.Errorf("expected 2 candle requests, but got %d", fake.calls)
+	}
+}
+
+func TestPairRoutes(t *testing.T) {
+	full := &fakeMarketDataExchange{&fakeCandleExchange{fakeExchange: newFakeExchange()}}
+	router := newRouter(full, common.Thresholds{})
+	basic := newRouter(newFakeExchange(), common.Thresholds{})
+
+	tests := []struct {
+		router *gin.Engine
+		url    string
+		status int
+	}{
+		{router, "/fake/klines/BTCUSDT?interval=15m&limit=10", http.StatusOK},
+		{router, "/fake/klines/BTCUSDT?interval=7m", http.StatusBadRequest},
+		{router, "/fake/klines/BTCUSDT?limit=0", http.StatusBadRequest},
+		{router, "/fake/klines/BTCUSDT?limit=abc", http.StatusBadRequest},
+		{router, "/fake/klines/BTCUSDT?startTime=yesterday", http.StatusBadRequest},
+		{router, "/fake/klines/BTCUSDT?market=option", http.StatusBadRequest},
+		{router, "/fake/indicators/BTCUSDT?set=rsi,sma:50", http.StatusOK},
+		{router, "/fake/indicators/BTCUSDT?set=magic", http.StatusBadRequest},
+		{router, "/fake/indicators/BTCUSDT?interval=2d", http.StatusBadRequest},
+		{router, "/fake/indicators/BTCUSDT?endTime=soon", http.StatusBadRequest},
+		{router, "/fake/depth/BTCUSDT?notional=1000", http.StatusOK},
+		{router, "/fake/depth/NOPEUSDT", http.StatusNotFound},
+		{router, "/fake/depth/BTCUSDT?limit=0", http.StatusBadRequest},
+		{router, "/fake/depth/BTCUSDT?notional=-1", http.StatusBadRequest},
+		{router, "/fake/trades/BTCUSDT?aggregate=true&largest=1", http.StatusOK},
+		{router, "/fake/trades/NOPEUSDT", http.StatusNotFound},
+		{router, "/fake/trades/BTCUSDT?limit=abc", http.StatusBadRequest},
+		{router, "/fake/trades/BTCUSDT?aggregate=maybe", http.StatusBadRequest},
+		{router, "/fake/trades/BTCUSDT?largest=-1", http.StatusBadRequest},
+		{router, "/fake/ticker/24hr/gainers?orderSize=abc", http.StatusBadRequest},
+		{router, "/fake/ticker/24hr/gainers?orderSize=1000&maxSlippageBps=abc", http.StatusBadRequest},
+		{router, "/fake/ticker/24hr/gainers?indicator=rsi%20%3C&indicatorInterval=1h", http.StatusBadRequest},
+		{router, "/fake/ticker/24hr/gainers?indicator=rsi%20%3C%2070&indicatorInterval=2d", http.StatusBadRequest},
+		{router, "/fake/ticker/24hr/gainers?orderSize=1000&maxSlippageBps=50", http.StatusOK},
+		{basic, "/fake/klines/BTCUSDT", http.StatusNotFound},
+		{basic, "/fake/indicators/BTCUSDT", http.StatusNotFound},
+		{basic, "/fake/depth/BTCUSDT", http.StatusNotFound},
+		{basic, "/fake/trades/BTCUSDT", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		if recorder := get(tt.router, tt.url); recorder.Code != tt.status {
+			t.Errorf("%s: expected status %d, but got %d: %s", tt.url, tt.status, recorder.Code, recorder.Body)
+		}
+	}
+}
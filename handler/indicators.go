@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"errors"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/filter"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/indicators"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+// indicatorConcurrency is the most candle requests in flight while checking an indicator
+// condition on ranked pairs.
+const indicatorConcurrency = 8
+
+// GetIndicators computes technical indicators of a trading pair.
+//
+//	@Summary		Compute technical indicators of a trading pair.
+//	@Description	This function fetches the candles of a trading pair and returns the latest value of every indicator of the set.
+//
+//	Indicators are sma, ema, rsi, macd, bollinger, atr and vwap, optionally followed by a period, e.g. rsi,macd,sma:50.
+//	The default periods are 20 for sma, ema, bollinger and vwap (a rolling VWAP) and 14 for rsi and atr; macd uses 12, 26 and 9 and bollinger two standard deviations.
+//	Values the candle history is too short for are null.
+//	Indicators are computed over closed candles only: the still open latest candle is left out, so values do not change within an interval.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange	path		string				true	"Exchange name"	Enums(binance, bybit)
+//	@Param			pair		path		string				true	"Trading pair symbol (e.g., BTCUSDT)"
+//	@Param			market		query		string				false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, inverse"
+//	@Param			interval	query		string				false	"Candle interval"															Enums(1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h, 1d, 1w, 1M)	default(1h)
+//	@Param			set			query		string				false	"Comma-separated indicators with optional periods, e.g. rsi,macd,sma:50"	default(rsi,macd)
+//	@Param			endTime		query		string				false	"Compute as of the candle open at this time, in Unix milliseconds or RFC 3339; default is now"
+//	@Success		200			{object}	indicators.Snapshot	"Latest indicator values keyed by indicator"
+//	@Failure		400			"Invalid market type, interval, set or time"
+//	@Failure		404			"Trading pair symbol is not listed in the market"
+//	@Failure		500			"Internal Server Error"
+//	@Router			/{exchange}/indicators/{pair} [get]
+func (h *ExchangeImpl) GetIndicators(c *gin.Context) {
+	exchange, ok := h.exchange.(parser.CandleExchange)
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Indicators are not supported on this exchange"})
+		return
+	}
+	market, ok := h.market(c)
+	if !ok {
+		return
+	}
+
+	interval := common.Interval(c.DefaultQuery("interval", string(common.DefaultCandleInterval)))
+	if !common.IsValidInterval(interval) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid interval"})
+		return
+	}
+	specs, err := indicators.ParseSet(c.DefaultQuery("set", "rsi,macd"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid set: " + err.Error()})
+		return
+	}
+	end, ok := timeQuery(c, "endTime")
+	if !ok {
+		return
+	}
+
+	pair := c.Param("pair")
+	query := common.CandleQuery{Interval: interval, End: end, Limit: indicators.Lookback(specs) + 1}
+	candles, err := exchange.Candles(market, pair, query)
+	if err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	candles = common.ClosedCandles(candles, interval, time.Now())
+	c.JSON(http.StatusOK, indicators.NewSnapshot(pair, interval, candles, specs))
+}
+
+// indicatorQuery parses the indicator condition into a check of the gainers query that
+// fetches the closed candles of the ranked pairs at indicatorInterval. It writes a 400
+// response and returns false when the condition is invalid or the exchange serves no
+// candles. The returned function reports the pairs whose candles could not be fetched
+// once the ranking is done.
+func (h *ExchangeImpl) indicatorQuery(c *gin.Context, query *common.GainersQuery, market string) (func() error, bool) {
+	none := func() error { return nil }
+	expression := c.Query("indicator")
+	if expression == "" {
+		return none, true
+	}
+
+	exchange, ok := h.exchange.(parser.CandleExchange)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Indicator conditions are not supported on this exchange"})
+		return none, false
+	}
+	interval := common.Interval(c.DefaultQuery("indicatorInterval", string(common.DefaultCandleInterval)))
+	if !common.IsValidInterval(interval) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid indicatorInterval"})
+		return none, false
+	}
+	condition, err := indicators.ParseCondition(expression)
+	if err != nil {
+		response := gin.H{"error": "Invalid indicator: " + err.Error()}
+		var filterErr *filter.Error
+		if errors.As(err, &filterErr) {
+			response["position"] = filterErr.Pos
+			response["token"] = filterErr.Token
+		}
+		c.JSON(http.StatusBadRequest, response)
+		return none, false
+	}
+
+	candleQuery := common.CandleQuery{Interval: interval, Limit: indicators.ConditionLookback() + 1}
+	fetch := func(symbol string) ([]common.Candle, error) {
+		candles, err := exchange.Candles(market, symbol, candleQuery)
+		return common.ClosedCandles(candles, interval, time.Now()), err
+	}
+	check, failed := indicators.Checker(fetch, condition, indicatorConcurrency)
+	query.Check = check
+	return failed, true
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// pageQuery reads the sort, order, offset, cursor, limit and fields parameters of a paged
+// list. paged reports whether the request asks for a page; otherwise the list is written
+// as before. On ranked lists a sort equal to a ranking sort key is applied by the ranking
+// and limit is the page size only for paged requests. It writes a 400 response and returns
+// false when a parameter is invalid.
+func pageQuery(c *gin.Context, ranked bool, defaultLimit int) (query common.PageQuery, paged bool, ok bool) {
+	query.Sort = c.Query("sort")
+	if ranked && common.IsValidSortBy(query.Sort) {
+		query.Sort = ""
+	}
+	query.Order = c.Query("order")
+	if !common.IsValidOrder(query.Order) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid order"})
+		return query, false, false
+	}
+	if fields := c.Query("fields"); fields != "" {
+		for _, field := range strings.Split(fields, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				query.Fields = append(query.Fields, field)
+			}
+		}
+	}
+
+	_, hasOffset := c.GetQuery("offset")
+	_, hasCursor := c.GetQuery("cursor")
+	_, hasLimit := c.GetQuery("limit")
+	paged = hasOffset || hasCursor || query.Sort != "" || query.Order != "" || len(query.Fields) > 0 || (hasLimit && !ranked)
+	if !paged {
+		return query, false, true
+	}
+
+	var err error
+	if query.Limit, err = strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultLimit))); err != nil || query.Limit < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return query, false, false
+	}
+	if cursor := c.Query("cursor"); cursor != "" {
+		if query.Offset, err = common.DecodeCursor(cursor); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid cursor"})
+			return query, false, false
+		}
+	} else if query.Offset, err = strconv.Atoi(c.DefaultQuery("offset", "0")); err != nil || query.Offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid offset"})
+		return query, false, false
+	}
+	return query, true, true
+}
+
+// writeList writes the list, or the page of it selected by the query when paged.
+func writeList(c *gin.Context, list interface{}, query common.PageQuery, paged bool) {
+	if !paged {
+		c.JSON(http.StatusOK, list)
+		return
+	}
+
+	page, err := common.Paginate(list, query)
+	if errors.Is(err, common.ErrUnknownField) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if errs, ok := c.Get(partialErrorsKey); ok {
+		page.Errors = errs.(map[string]string)
+	}
+	c.JSON(http.StatusOK, page)
+}
+
+// partialErrorsKey is the context key of the symbols an exchange could not fetch.
+const partialErrorsKey = "partialErrors"
+
+// partialErrors reports the symbols of a *common.PartialError in the X-Partial-Errors
+// header, a JSON object keyed by symbol, and in the errors field of paged lists. It
+// returns nil for a partial error so that the fetched rows are still written, and any
+// other error unchanged.
+func partialErrors(c *gin.Context, err error) error {
+	var partial *common.PartialError
+	if !errors.As(err, &partial) {
+		return err
+	}
+	encoded, err := json.Marshal(partial.Errors)
+	if err != nil {
+		return err
+	}
+	c.Header("X-Partial-Errors", string(encoded))
+	c.Set(partialErrorsKey, partial.Errors)
+	return nil
+}
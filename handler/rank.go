@@ -0,0 +1,430 @@
+package handler
+
+import (
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// Check limits: the number of ranked pairs the indicator condition and the depth filter
+// check by default and at most, since every check fetches candles or an order book.
+const (
+	defaultCheckLimit = 50
+	maxCheckLimit     = 200
+)
+
+// Get24HourLosersTickerData retrieves a list of top losing trading pairs over the last 24 hours.
+//
+//	@Summary		Retrieve top losers in a specified market.
+//	@Description	This function fetches trading pairs that have lost value over the last 24 hours, largest loss first.
+//
+//	It accepts the same filters as the gainers route; use maxChangePercent (e.g. -5) to keep only steep losses.
+//	Kraken reports no rolling 24-hour open, so its change is measured from the open at UTC midnight (see open_time of the normalized rows).
+//	Coinbase products whose stats could not be fetched are left out of the ranking and reported in the X-Partial-Errors header.
+//
+//	Paging parameters (offset, cursor, order, fields, or a sort by a row field) return a page {total, offset, limit, next_cursor, data} of the whole ranking, with limit as the page size.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange			path	string	true	"Exchange name"									Enums(binance, bybit, okx, kraken, coinbase, kucoin)
+//	@Param			limit				query	int		false	"Limit the number of results; default is 500"	default(500)
+//	@Param			endingFilter		query	string	false	"Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD"
+//	@Param			exclude				query	string	false	"Comma-separated values to drop, matched by excludeMode"
+//	@Param			excludeMode			query	string	false	"Match mode of exclude; default is contains (symbol substring)"	Enums(contains, base, prefix, suffix, regex)
+//	@Param			include				query	string	false	"Comma-separated values to keep, matched by includeMode"
+//	@Param			includeMode			query	string	false	"Match mode of include; default is base (exact base asset)"					Enums(base, contains, prefix, suffix, regex)
+//	@Param			excludeLeveraged	query	bool	false	"Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)"						default(false)
+//	@Param			excludeStablePairs	query	bool	false	"Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)"	default(false)
+//	@Param			excludeFiatPairs	query	bool	false	"Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)"		default(false)
+//	@Param			market				query	string	false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot"
+//	@Param			baseCoin			query	string	false	"Underlying of the option market (bybit); defaults to BTC"
+//	@Param			sort				query	string	false	"Ranking sort key of bybit linear and inverse markets (change, funding_rate, open_interest, open_interest_value), or any JSON field of the rows to page by"
+//	@Param			order				query	string	false	"Page order: desc (default) or asc; without a field sort, asc reverses the ranking"	Enums(asc, desc)
+//	@Param			offset				query	int		false	"Number of rows to skip; returns a page with the total count"
+//	@Param			cursor				query	string	false	"next_cursor of the previous page; takes precedence over offset"
+//	@Param			fields				query	string	false	"Comma-separated JSON fields to keep in every row"
+//	@Param			minChangePercent	query	number	false	"Keep pairs whose 24-hour change is at least this percentage"
+//	@Param			maxChangePercent	query	number	false	"Keep pairs whose 24-hour change is at most this percentage"
+//	@Param			minQuoteVolume		query	number	false	"Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount"
+//	@Param			minTradeCount		query	int		false	"Keep pairs with at least this many trades; applied where the exchange reports trade counts"
+//	@Param			minLastPrice		query	number	false	"Keep pairs whose last price is at least this value"
+//	@Param			maxSpreadPercent	query	number	false	"Keep pairs whose bid/ask spread is at most this percentage of the mid price"
+//	@Param			maxTickPercent		query	number	false	"Drop dust-priced pairs whose price tick is more than this percentage of the last price"
+//	@Param			filter				query	string	false	"Filter expression over normalized ticker fields, e.g. change_pct > 5 && quote_volume > 1e6"
+//	@Param			window				query	string	false	"Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse"	Enums(1h, 4h, 1d, 7d)
+//	@Param			indicator			query	string	false	"Indicator condition on the closed candles of every ranked pair, e.g. rsi < 70 && close > sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap"
+//	@Param			indicatorInterval	query	string	false	"Candle interval of the indicator condition"	Enums(1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h, 1d, 1w, 1M)	default(1h)
+//	@Param			orderSize			query	number	false	"Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side"
+//	@Param			maxSlippageBps		query	number	false	"Maximum slippage from the mid price, in basis points, of the orderSize filter"																		default(50)
+//	@Param			checkLimit			query	int		false	"Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200"	default(50)
+//	@Success		200					{array}	object	"List of native ticker data representing top losers"
+//	@Failure		400					"Invalid market type or query parameters"
+//	@Failure		500					"Internal Server Error"
+//	@Router			/{exchange}/ticker/24hr/losers [get]
+func (h *ExchangeImpl) Get24HourLosersTickerData(c *gin.Context) {
+	h.rank(c, common.RankingLosers)
+}
+
+// Get24HourLosersPairs retrieves the top losing trading pairs over the last 24 hours as a pair list.
+//
+//	@Summary		Retrieve top losers in a specified market as a pair list.
+//	@Description	This function returns the trading pairs that have lost value over the last 24 hours, largest loss first, in the Freqtrade remote pair list format.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange			path		string				true	"Exchange name"																	Enums(binance, bybit, okx, kraken, coinbase, kucoin)
+//	@Param			limit				query		int					false	"Limit the number of results; default is 100"									default(100)
+//	@Param			endingFilter		query		string				false	"Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD; default is 'USDT'"	default(USDT)
+//	@Param			exclude				query		string				false	"Comma-separated values to drop, matched by excludeMode; default is 'BNB'"		default(BNB)
+//	@Param			excludeMode			query		string				false	"Match mode of exclude; default is contains (symbol substring)"					Enums(contains, base, prefix, suffix, regex)
+//	@Param			include				query		string				false	"Comma-separated values to keep, matched by includeMode"
+//	@Param			includeMode			query		string				false	"Match mode of include; default is base (exact base asset)"					Enums(base, contains, prefix, suffix, regex)
+//	@Param			excludeLeveraged	query		bool				false	"Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)"						default(false)
+//	@Param			excludeStablePairs	query		bool				false	"Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)"	default(false)
+//	@Param			excludeFiatPairs	query		bool				false	"Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)"		default(false)
+//	@Param			market				query		string				false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot"
+//	@Param			baseCoin			query		string				false	"Underlying of the option market (bybit); defaults to BTC"
+//	@Param			sort				query		string				false	"Ranking sort key of bybit linear and inverse markets; pair lists are not paged, so other fields return 400"	Enums(change, funding_rate, open_interest, open_interest_value)
+//	@Param			minChangePercent	query		number				false	"Keep pairs whose 24-hour change is at least this percentage"
+//	@Param			maxChangePercent	query		number				false	"Keep pairs whose 24-hour change is at most this percentage"
+//	@Param			minQuoteVolume		query		number				false	"Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount"
+//	@Param			minTradeCount		query		int					false	"Keep pairs with at least this many trades; applied where the exchange reports trade counts"
+//	@Param			minLastPrice		query		number				false	"Keep pairs whose last price is at least this value"
+//	@Param			maxSpreadPercent	query		number				false	"Keep pairs whose bid/ask spread is at most this percentage of the mid price"
+//	@Param			maxTickPercent		query		number				false	"Drop dust-priced pairs whose price tick is more than this percentage of the last price"
+//	@Param			filter				query		string				false	"Filter expression over normalized ticker fields, e.g. change_pct > 5 && quote_volume > 1e6"
+//	@Param			window				query		string				false	"Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse"	Enums(1h, 4h, 1d, 7d)
+//	@Param			indicator			query		string				false	"Indicator condition on the closed candles of every ranked pair, e.g. rsi < 70 && close > sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap"
+//	@Param			indicatorInterval	query		string				false	"Candle interval of the indicator condition"	Enums(1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h, 1d, 1w, 1M)	default(1h)
+//	@Param			orderSize			query		number				false	"Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side"
+//	@Param			maxSlippageBps		query		number				false	"Maximum slippage from the mid price, in basis points, of the orderSize filter"																		default(50)
+//	@Param			checkLimit			query		int					false	"Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200"	default(50)
+//	@Success		200					{object}	PairListResponse	"PairListResponse representing top losers"
+//	@Failure		400					"Invalid market type or query parameters"
+//	@Failure		500					"Internal Server Error"
+//	@Router			/{exchange}/ticker/24hr/losers/pairs [get]
+func (h *ExchangeImpl) Get24HourLosersPairs(c *gin.Context) {
+	h.rankPairs(c, common.RankingLosers)
+}
+
+// Get24HourVolumeTickerData retrieves the most traded trading pairs over the last 24 hours.
+//
+//	@Summary		Retrieve the top trading pairs by quote volume in a specified market.
+//	@Description	This function fetches trading pairs ranked by their 24-hour quote volume (turnover), largest first.
+//
+//	Volumes are compared in USD: quote volumes in other assets are converted at their USD price from the same tickers.
+//
+//	Paging parameters (offset, cursor, order, fields, or a sort by a row field) return a page {total, offset, limit, next_cursor, data} of the whole ranking, with limit as the page size.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange			path	string	true	"Exchange name"									Enums(binance, bybit, okx, kraken, coinbase, kucoin)
+//	@Param			limit				query	int		false	"Limit the number of results; default is 500"	default(500)
+//	@Param			endingFilter		query	string	false	"Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD"
+//	@Param			exclude				query	string	false	"Comma-separated values to drop, matched by excludeMode"
+//	@Param			excludeMode			query	string	false	"Match mode of exclude; default is contains (symbol substring)"	Enums(contains, base, prefix, suffix, regex)
+//	@Param			include				query	string	false	"Comma-separated values to keep, matched by includeMode"
+//	@Param			includeMode			query	string	false	"Match mode of include; default is base (exact base asset)"					Enums(base, contains, prefix, suffix, regex)
+//	@Param			excludeLeveraged	query	bool	false	"Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)"						default(false)
+//	@Param			excludeStablePairs	query	bool	false	"Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)"	default(false)
+//	@Param			excludeFiatPairs	query	bool	false	"Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)"		default(false)
+//	@Param			market				query	string	false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot"
+//	@Param			baseCoin			query	string	false	"Underlying of the option market (bybit); defaults to BTC"
+//	@Param			sort				query	string	false	"Ranking sort key of bybit linear and inverse markets (change, funding_rate, open_interest, open_interest_value), or any JSON field of the rows to page by"
+//	@Param			order				query	string	false	"Page order: desc (default) or asc; without a field sort, asc reverses the ranking"	Enums(asc, desc)
+//	@Param			offset				query	int		false	"Number of rows to skip; returns a page with the total count"
+//	@Param			cursor				query	string	false	"next_cursor of the previous page; takes precedence over offset"
+//	@Param			fields				query	string	false	"Comma-separated JSON fields to keep in every row"
+//	@Param			minChangePercent	query	number	false	"Keep pairs whose 24-hour change is at least this percentage"
+//	@Param			maxChangePercent	query	number	false	"Keep pairs whose 24-hour change is at most this percentage"
+//	@Param			minQuoteVolume		query	number	false	"Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount"
+//	@Param			minTradeCount		query	int		false	"Keep pairs with at least this many trades; applied where the exchange reports trade counts"
+//	@Param			minLastPrice		query	number	false	"Keep pairs whose last price is at least this value"
+//	@Param			maxSpreadPercent	query	number	false	"Keep pairs whose bid/ask spread is at most this percentage of the mid price"
+//	@Param			maxTickPercent		query	number	false	"Drop dust-priced pairs whose price tick is more than this percentage of the last price"
+//	@Param			filter				query	string	false	"Filter expression over normalized ticker fields, e.g. change_pct > 5 && quote_volume > 1e6"
+//	@Param			window				query	string	false	"Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse"	Enums(1h, 4h, 1d, 7d)
+//	@Param			indicator			query	string	false	"Indicator condition on the closed candles of every ranked pair, e.g. rsi < 70 && close > sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap"
+//	@Param			indicatorInterval	query	string	false	"Candle interval of the indicator condition"	Enums(1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h, 1d, 1w, 1M)	default(1h)
+//	@Param			orderSize			query	number	false	"Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side"
+//	@Param			maxSlippageBps		query	number	false	"Maximum slippage from the mid price, in basis points, of the orderSize filter"																		default(50)
+//	@Param			checkLimit			query	int		false	"Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200"	default(50)
+//	@Success		200					{array}	object	"List of native ticker data, largest quote volume first"
+//	@Failure		400					"Invalid market type or query parameters"
+//	@Failure		500					"Internal Server Error"
+//	@Router			/{exchange}/ticker/24hr/volume [get]
+func (h *ExchangeImpl) Get24HourVolumeTickerData(c *gin.Context) {
+	h.rank(c, common.RankingVolume)
+}
+
+// Get24HourVolumePairs retrieves the most traded trading pairs over the last 24 hours as a pair list.
+//
+//	@Summary		Retrieve the top trading pairs by quote volume as a pair list.
+//	@Description	This function returns the trading pairs ranked by their USD-equivalent 24-hour quote volume, largest first, in the Freqtrade remote pair list format.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange			path		string				true	"Exchange name"																	Enums(binance, bybit, okx, kraken, coinbase, kucoin)
+//	@Param			limit				query		int					false	"Limit the number of results; default is 100"									default(100)
+//	@Param			endingFilter		query		string				false	"Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD; default is 'USDT'"	default(USDT)
+//	@Param			exclude				query		string				false	"Comma-separated values to drop, matched by excludeMode; default is 'BNB'"		default(BNB)
+//	@Param			excludeMode			query		string				false	"Match mode of exclude; default is contains (symbol substring)"					Enums(contains, base, prefix, suffix, regex)
+//	@Param			include				query		string				false	"Comma-separated values to keep, matched by includeMode"
+//	@Param			includeMode			query		string				false	"Match mode of include; default is base (exact base asset)"					Enums(base, contains, prefix, suffix, regex)
+//	@Param			excludeLeveraged	query		bool				false	"Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)"						default(false)
+//	@Param			excludeStablePairs	query		bool				false	"Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)"	default(false)
+//	@Param			excludeFiatPairs	query		bool				false	"Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)"		default(false)
+//	@Param			market				query		string				false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot"
+//	@Param			baseCoin			query		string				false	"Underlying of the option market (bybit); defaults to BTC"
+//	@Param			sort				query		string				false	"Ranking sort key of bybit linear and inverse markets; pair lists are not paged, so other fields return 400"	Enums(change, funding_rate, open_interest, open_interest_value)
+//	@Param			minChangePercent	query		number				false	"Keep pairs whose 24-hour change is at least this percentage"
+//	@Param			maxChangePercent	query		number				false	"Keep pairs whose 24-hour change is at most this percentage"
+//	@Param			minQuoteVolume		query		number				false	"Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount"
+//	@Param			minTradeCount		query		int					false	"Keep pairs with at least this many trades; applied where the exchange reports trade counts"
+//	@Param			minLastPrice		query		number				false	"Keep pairs whose last price is at least this value"
+//	@Param			maxSpreadPercent	query		number				false	"Keep pairs whose bid/ask spread is at most this percentage of the mid price"
+//	@Param			maxTickPercent		query		number				false	"Drop dust-priced pairs whose price tick is more than this percentage of the last price"
+//	@Param			filter				query		string				false	"Filter expression over normalized ticker fields, e.g. change_pct > 5 && quote_volume > 1e6"
+//	@Param			window				query		string				false	"Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse"	Enums(1h, 4h, 1d, 7d)
+//	@Param			indicator			query		string				false	"Indicator condition on the closed candles of every ranked pair, e.g. rsi < 70 && close > sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap"
+//	@Param			indicatorInterval	query		string				false	"Candle interval of the indicator condition"	Enums(1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h, 1d, 1w, 1M)	default(1h)
+//	@Param			orderSize			query		number				false	"Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side"
+//	@Param			maxSlippageBps		query		number				false	"Maximum slippage from the mid price, in basis points, of the orderSize filter"																		default(50)
+//	@Param			checkLimit			query		int					false	"Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200"	default(50)
+//	@Success		200					{object}	PairListResponse	"PairListResponse, largest quote volume first"
+//	@Failure		400					"Invalid market type or query parameters"
+//	@Failure		500					"Internal Server Error"
+//	@Router			/{exchange}/ticker/24hr/volume/pairs [get]
+func (h *ExchangeImpl) Get24HourVolumePairs(c *gin.Context) {
+	h.rankPairs(c, common.RankingVolume)
+}
+
+// Get24HourVolatileTickerData retrieves the most volatile trading pairs over the last 24 hours.
+//
+//	@Summary		Retrieve the most volatile trading pairs in a specified market.
+//	@Description	This function fetches trading pairs ranked by their 24-hour range, (high - low) / open, widest first.
+//
+//	Paging parameters (offset, cursor, order, fields, or a sort by a row field) return a page {total, offset, limit, next_cursor, data} of the whole ranking, with limit as the page size.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange			path	string	true	"Exchange name"									Enums(binance, bybit, okx, kraken, coinbase, kucoin)
+//	@Param			limit				query	int		false	"Limit the number of results; default is 500"	default(500)
+//	@Param			endingFilter		query	string	false	"Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD"
+//	@Param			exclude				query	string	false	"Comma-separated values to drop, matched by excludeMode"
+//	@Param			excludeMode			query	string	false	"Match mode of exclude; default is contains (symbol substring)"	Enums(contains, base, prefix, suffix, regex)
+//	@Param			include				query	string	false	"Comma-separated values to keep, matched by includeMode"
+//	@Param			includeMode			query	string	false	"Match mode of include; default is base (exact base asset)"					Enums(base, contains, prefix, suffix, regex)
+//	@Param			excludeLeveraged	query	bool	false	"Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)"						default(false)
+//	@Param			excludeStablePairs	query	bool	false	"Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)"	default(false)
+//	@Param			excludeFiatPairs	query	bool	false	"Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)"		default(false)
+//	@Param			market				query	string	false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot"
+//	@Param			baseCoin			query	string	false	"Underlying of the option market (bybit); defaults to BTC"
+//	@Param			sort				query	string	false	"Ranking sort key of bybit linear and inverse markets (change, funding_rate, open_interest, open_interest_value), or any JSON field of the rows to page by"
+//	@Param			order				query	string	false	"Page order: desc (default) or asc; without a field sort, asc reverses the ranking"	Enums(asc, desc)
+//	@Param			offset				query	int		false	"Number of rows to skip; returns a page with the total count"
+//	@Param			cursor				query	string	false	"next_cursor of the previous page; takes precedence over offset"
+//	@Param			fields				query	string	false	"Comma-separated JSON fields to keep in every row"
+//	@Param			minChangePercent	query	number	false	"Keep pairs whose 24-hour change is at least this percentage"
+//	@Param			maxChangePercent	query	number	false	"Keep pairs whose 24-hour change is at most this percentage"
+//	@Param			minQuoteVolume		query	number	false	"Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount"
+//	@Param			minTradeCount		query	int		false	"Keep pairs with at least this many trades; applied where the exchange reports trade counts"
+//	@Param			minLastPrice		query	number	false	"Keep pairs whose last price is at least this value"
+//	@Param			maxSpreadPercent	query	number	false	"Keep pairs whose bid/ask spread is at most this percentage of the mid price"
+//	@Param			maxTickPercent		query	number	false	"Drop dust-priced pairs whose price tick is more than this percentage of the last price"
+//	@Param			filter				query	string	false	"Filter expression over normalized ticker fields, e.g. change_pct > 5 && quote_volume > 1e6"
+//	@Param			window				query	string	false	"Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse"	Enums(1h, 4h, 1d, 7d)
+//	@Param			indicator			query	string	false	"Indicator condition on the closed candles of every ranked pair, e.g. rsi < 70 && close > sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap"
+//	@Param			indicatorInterval	query	string	false	"Candle interval of the indicator condition"	Enums(1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h, 1d, 1w, 1M)	default(1h)
+//	@Param			orderSize			query	number	false	"Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side"
+//	@Param			maxSlippageBps		query	number	false	"Maximum slippage from the mid price, in basis points, of the orderSize filter"																		default(50)
+//	@Param			checkLimit			query	int		false	"Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200"	default(50)
+//	@Success		200					{array}	object	"List of native ticker data, widest range first"
+//	@Failure		400					"Invalid market type or query parameters"
+//	@Failure		500					"Internal Server Error"
+//	@Router			/{exchange}/ticker/24hr/volatile [get]
+func (h *ExchangeImpl) Get24HourVolatileTickerData(c *gin.Context) {
+	h.rank(c, common.RankingVolatile)
+}
+
+// Get24HourVolatilePairs retrieves the most volatile trading pairs over the last 24 hours as a pair list.
+//
+//	@Summary		Retrieve the most volatile trading pairs as a pair list.
+//	@Description	This function returns the trading pairs ranked by their 24-hour (high - low) / open range, widest first, in the Freqtrade remote pair list format.
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange			path		string				true	"Exchange name"																	Enums(binance, bybit, okx, kraken, coinbase, kucoin)
+//	@Param			limit				query		int					false	"Limit the number of results; default is 100"									default(100)
+//	@Param			endingFilter		query		string				false	"Comma-separated quote assets to keep, e.g. USDT,USDC,FDUSD; default is 'USDT'"	default(USDT)
+//	@Param			exclude				query		string				false	"Comma-separated values to drop, matched by excludeMode; default is 'BNB'"		default(BNB)
+//	@Param			excludeMode			query		string				false	"Match mode of exclude; default is contains (symbol substring)"					Enums(contains, base, prefix, suffix, regex)
+//	@Param			include				query		string				false	"Comma-separated values to keep, matched by includeMode"
+//	@Param			includeMode			query		string				false	"Match mode of include; default is base (exact base asset)"					Enums(base, contains, prefix, suffix, regex)
+//	@Param			excludeLeveraged	query		bool				false	"Drop leveraged tokens (e.g. BTCUP, ETHBEAR, BTC3L)"						default(false)
+//	@Param			excludeStablePairs	query		bool				false	"Drop stablecoins quoted in a stablecoin or fiat currency (e.g. USDCUSDT)"	default(false)
+//	@Param			excludeFiatPairs	query		bool				false	"Drop fiat currencies traded as base assets (e.g. EURUSDT, TRYUSDT)"		default(false)
+//	@Param			market				query		string				false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse; okx: spot, swap, futures, option (BTC-USD family only); others: spot"
+//	@Param			baseCoin			query		string				false	"Underlying of the option market (bybit); defaults to BTC"
+//	@Param			sort				query		string				false	"Ranking sort key of bybit linear and inverse markets; pair lists are not paged, so other fields return 400"	Enums(change, funding_rate, open_interest, open_interest_value)
+//	@Param			minChangePercent	query		number				false	"Keep pairs whose 24-hour change is at least this percentage"
+//	@Param			maxChangePercent	query		number				false	"Keep pairs whose 24-hour change is at most this percentage"
+//	@Param			minQuoteVolume		query		number				false	"Keep pairs whose 24-hour quote volume is at least this USD-equivalent amount"
+//	@Param			minTradeCount		query		int					false	"Keep pairs with at least this many trades; applied where the exchange reports trade counts"
+//	@Param			minLastPrice		query		number				false	"Keep pairs whose last price is at least this value"
+//	@Param			maxSpreadPercent	query		number				false	"Keep pairs whose bid/ask spread is at most this percentage of the mid price"
+//	@Param			maxTickPercent		query		number				false	"Drop dust-priced pairs whose price tick is more than this percentage of the last price"
+//	@Param			filter				query		string				false	"Filter expression over normalized ticker fields, e.g. change_pct > 5 && quote_volume > 1e6"
+//	@Param			window				query		string				false	"Rolling window to rank by instead of 24 hours; change bounds and filter apply to the window, liquidity thresholds to 24 hours. binance: spot; bybit: spot, linear, inverse"	Enums(1h, 4h, 1d, 7d)
+//	@Param			indicator			query		string				false	"Indicator condition on the closed candles of every ranked pair, e.g. rsi < 70 && close > sma; fields: close, sma, ema, rsi, macd, macd_signal, macd_hist, bb_upper, bb_middle, bb_lower, atr, vwap"
+//	@Param			indicatorInterval	query		string				false	"Candle interval of the indicator condition"	Enums(1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h, 1d, 1w, 1M)	default(1h)
+//	@Param			orderSize			query		number				false	"Order size in the quote asset; keeps only the ranked pairs on which a buy and a sell of this size fill within maxSlippageBps of the mid price, within the best 100 levels per side"
+//	@Param			maxSlippageBps		query		number				false	"Maximum slippage from the mid price, in basis points, of the orderSize filter"																		default(50)
+//	@Param			checkLimit			query		int					false	"Most ranked pairs checked by the indicator condition and the orderSize filter, in ranking order; pairs ranked after them are dropped. At most 200"	default(50)
+//	@Success		200					{object}	PairListResponse	"PairListResponse, widest range first"
+//	@Failure		400					"Invalid market type or query parameters"
+//	@Failure		500					"Internal Server Error"
+//	@Router			/{exchange}/ticker/24hr/volatile/pairs [get]
+func (h *ExchangeImpl) Get24HourVolatilePairs(c *gin.Context) {
+	h.rankPairs(c, common.RankingVolatile)
+}
+
+// rank writes the ranked native tickers of the requested market, or a page of them when
+// paging parameters are given. Gainers of an option market are ranked by the option
+// ranking keys instead.
+func (h *ExchangeImpl) rank(c *gin.Context, ranking common.Ranking) {
+	market, ok := h.market(c)
+	if !ok {
+		return
+	}
+	window, ok := h.windowQuery(c, market)
+	if !ok {
+		return
+	}
+
+	page, paged, ok := pageQuery(c, true, 500)
+	if !ok {
+		return
+	}
+	limit, ok := limitQuery(c, 500)
+	if !ok {
+		return
+	}
+	if paged {
+		limit = 0
+	}
+
+	var tickerData interface{}
+	var err error
+	if options, ok := h.options(market); ok && ranking == common.RankingGainers {
+		query, ok := optionsQuery(c, limit)
+		if !ok {
+			return
+		}
+		tickerData, err = options.Options(query)
+	} else {
+		query := common.GainersQuery{
+			Limit:         limit,
+			EndingFilter:  c.DefaultQuery("endingFilter", ""),
+			ExcludeFilter: c.DefaultQuery("exclude", ""),
+			Window:        window,
+			BaseCoin:      c.Query("baseCoin"),
+		}
+		if !derivativeQuery(c, &query) || !thresholdsQuery(c, &query, h.thresholds.ForRanking(ranking)) || !matchQuery(c, &query) || !categoryQuery(c, &query) || !filterQuery(c, &query) {
+			return
+		}
+		failed, ok := h.checkQuery(c, &query, market)
+		if !ok {
+			return
+		}
+		tickerData, err = h.exchange.Rank(market, ranking, query)
+		err = partialErrors(c, common.MergePartial(err, failed()))
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	writeList(c, tickerData, page, paged)
+}
+
+// rankPairs writes the ranked pairs of the requested market as a pair list. Gainers of an
+// option market are ranked by the option ranking keys instead. Pair lists are not paged,
+// so sort only accepts the ranking sort keys.
+func (h *ExchangeImpl) rankPairs(c *gin.Context, ranking common.Ranking) {
+	market, ok := h.market(c)
+	if !ok {
+		return
+	}
+	window, ok := h.windowQuery(c, market)
+	if !ok {
+		return
+	}
+	if !common.IsValidSortBy(c.Query("sort")) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sort"})
+		return
+	}
+
+	limit, ok := limitQuery(c, 100)
+	if !ok {
+		return
+	}
+	var pairs PairListResponse
+	var err error
+	if options, ok := h.options(market); ok && ranking == common.RankingGainers {
+		query, ok := optionsQuery(c, limit)
+		if !ok {
+			return
+		}
+		query.ExcludeFilter = c.Query("exclude")
+		pairs, err = options.OptionPairs(query)
+	} else {
+		query := common.GainersQuery{
+			Limit:         limit,
+			EndingFilter:  c.DefaultQuery("endingFilter", "USDT"),
+			ExcludeFilter: c.DefaultQuery("exclude", "BNB"),
+			Window:        window,
+			BaseCoin:      c.Query("baseCoin"),
+		}
+		if !derivativeQuery(c, &query) || !thresholdsQuery(c, &query, h.thresholds.ForRanking(ranking)) || !matchQuery(c, &query) || !categoryQuery(c, &query) || !filterQuery(c, &query) {
+			return
+		}
+		failed, ok := h.checkQuery(c, &query, market)
+		if !ok {
+			return
+		}
+		pairs, err = h.exchange.RankPairs(market, ranking, query)
+		err = partialErrors(c, common.MergePartial(err, failed()))
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, pairs)
+}
+
+// checkQuery adds the indicator condition and the depth filter of the request to the
+// checks of the gainers query, see indicatorQuery and depthQuery, and bounds the number
+// of ranked pairs they check by checkLimit. It writes a 400 response and returns false
+// when a parameter is invalid. The returned function reports the pairs whose checks
+// failed in a *common.PartialError once the ranking is done.
+func (h *ExchangeImpl) checkQuery(c *gin.Context, query *common.GainersQuery, market string) (func() error, bool) {
+	checkLimit, err := strconv.Atoi(c.DefaultQuery("checkLimit", strconv.Itoa(defaultCheckLimit)))
+	if err != nil || checkLimit <= 0 || checkLimit > maxCheckLimit {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid checkLimit"})
+		return nil, false
+	}
+	query.CheckLimit = checkLimit
+
+	indicatorFailed, ok := h.indicatorQuery(c, query, market)
+	if !ok {
+		return nil, false
+	}
+	depthFailed, ok := h.depthQuery(c, query, market)
+	if !ok {
+		return nil, false
+	}
+	return func() error {
+		return common.MergePartial(indicatorFailed(), depthFailed())
+	}, true
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetTrades retrieves the recent trades of a trading pair with their taker flow metrics.
+//
+//	@Summary		Retrieve the recent trades and taker flow of a trading pair.
+//	@Description	This function returns the most recent trades of a trading pair, oldest first, with the quote volume bought and sold by takers, their buy/sell ratio, the number of trades per minute and the largest trades by quote quantity. The metrics cover the window from the first to the last returned trade.
+//
+//	binance returns up to 1000 trades, bybit up to 60 (spot) or 1000 (linear, option, inverse). With aggregate, binance returns its trades aggregated by taker order and price (spot and linear only).
+//
+//	@Produce		json
+//	@Tags			Exchanges
+//	@Param			exchange	path		string				true	"Exchange name"	Enums(binance, bybit)
+//	@Param			pair		path		string				true	"Trading pair symbol (e.g., BTCUSDT)"
+//	@Param			market		query		string				false	"Market type; defaults to spot. binance: spot, linear (USD-M), inverse (COIN-M); bybit: spot, linear, option, inverse"
+//	@Param			limit		query		int					false	"Number of recent trades; default is 500, capped by the exchange"	default(500)
+//	@Param			aggregate	query		bool				false	"Aggregate trades by taker order and price; binance spot and linear only"
+//	@Param			largest		query		int					false	"Number of largest trades to report; default is 5"	default(5)
+//	@Success		200			{object}	common.TradeReport	"Recent trades with taker flow metrics"
+//	@Failure		400			"Invalid market type, limit, aggregate or largest"
+//	@Failure		404			"Trading pair symbol is not listed in the market"
+//	@Failure		500			"Internal Server Error"
+//	@Router			/{exchange}/trades/{pair} [get]
+func (h *ExchangeImpl) GetTrades(c *gin.Context) {
+	exchange, ok := h.exchange.(parser.TradeExchange)
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Trades are not supported on this exchange"})
+		return
+	}
+	market, ok := h.market(c)
+	if !ok {
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(common.DefaultTradeLimit)))
+	if err != nil || limit <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return
+	}
+	aggregate, err := strconv.ParseBool(c.DefaultQuery("aggregate", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid aggregate"})
+		return
+	}
+	largest, err := strconv.Atoi(c.DefaultQuery("largest", strconv.Itoa(common.DefaultLargestTrades)))
+	if err != nil || largest < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid largest"})
+		return
+	}
+
+	pair := c.Param("pair")
+	trades, err := exchange.Trades(market, pair, common.TradeQuery{Limit: limit, Aggregate: aggregate})
+	if err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, common.TradeReport{
+		Exchange: h.exchange.Name(),
+		Market:   market,
+		Symbol:   pair,
+		Trades:   trades,
+		Metrics:  common.AnalyzeTrades(trades, largest),
+	})
+}
@@ -26,22 +26,38 @@ func main() {
 	router := gin.Default()
 	v1 := router.Group("/api/v1")
 	{
-		// Define routes under the "/binance" group
-		binance := v1.Group("/binance")
-		{
-			binance.GET("/ticker/24hr", handlers.Binance().Get24HourTickerData)
-			binance.GET("/ticker/24hr/:pair", handlers.Binance().GetTickerForPair)
-			binance.GET("/ticker/24hr/gainers", handlers.Binance().Get24HourGainersTickerData)
-			binance.GET("/ticker/24hr/gainers/pairs", handlers.Binance().Get24HourGainersPairs)
+		// Define routes aggregated across every configured exchange
+		v1.GET("/gainers", handlers.Aggregate().Get24HourGainersTickerData)
+		v1.GET("/spread", handlers.Aggregate().GetSpreads)
 
-		} // Define routes under the "/binance" group
-		bybit := v1.Group("/bybit")
-		{
-			bybit.GET("/ticker/24hr", handlers.Bybit().Get24HourTickerData)
-			bybit.GET("/ticker/24hr/:pair", handlers.Bybit().GetTickerForPair)
-			bybit.GET("/ticker/24hr/gainers", handlers.Bybit().Get24HourGainersTickerData)
-			bybit.GET("/ticker/24hr/gainers/pairs", handlers.Bybit().Get24HourGainersPairs)
+		// Define routes under one group per configured exchange, e.g. "/binance"
+		for _, exchange := range parser_.Exchanges() {
+			h := handlers.Exchange(exchange)
+			group := v1.Group("/" + exchange.Name())
+			{
+				group.GET("/ticker/24hr", h.Get24HourTickerData)
+				group.GET("/ticker/24hr/:pair", h.GetTickerForPair)
+				group.GET("/ticker/24hr/gainers", h.Get24HourGainersTickerData)
+				group.GET("/ticker/24hr/gainers/pairs", h.Get24HourGainersPairs)
+				group.GET("/ticker/24hr/losers", h.Get24HourLosersTickerData)
+				group.GET("/ticker/24hr/losers/pairs", h.Get24HourLosersPairs)
+				group.GET("/ticker/24hr/volume", h.Get24HourVolumeTickerData)
+				group.GET("/ticker/24hr/volume/pairs", h.Get24HourVolumePairs)
+				group.GET("/ticker/24hr/volatile", h.Get24HourVolatileTickerData)
+				group.GET("/ticker/24hr/volatile/pairs", h.Get24HourVolatilePairs)
+				group.GET("/symbols", h.GetSymbols)
 
+				if _, ok := exchange.(parser.CandleExchange); ok {
+					group.GET("/klines/:pair", h.GetCandles)
+					group.GET("/indicators/:pair", h.GetIndicators)
+				}
+				if _, ok := exchange.(parser.DepthExchange); ok {
+					group.GET("/depth/:pair", h.GetDepth)
+				}
+				if _, ok := exchange.(parser.TradeExchange); ok {
+					group.GET("/trades/:pair", h.GetTrades)
+				}
+			}
 		}
 	}
 
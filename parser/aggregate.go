@@ -0,0 +1,135 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// AggregatedGainers is the ranked list of gainers across several exchanges.
+//
+// Errors is keyed by "exchange/market" and lists the sources that could not be
+// fetched, or only partly fetched; the remaining tickers are still ranked.
+type AggregatedGainers struct {
+	Tickers []common.Ticker   `json:"tickers"`
+	Errors  map[string]string `json:"errors,omitempty"`
+}
+
+// optionMarket is the market type of option contracts, which are left out of aggregations
+// unless requested since their prices move with the underlying and time to expiry.
+const optionMarket = "option"
+
+// source is a single exchange market queried by an aggregation.
+type source struct {
+	exchange Exchange
+	market   string
+}
+
+func (s source) String() string {
+	return s.exchange.Name() + "/" + s.market
+}
+
+// AggregateGainers fetches normalized tickers from the given markets of the exchanges
+// concurrently and ranks the gainers across all of them. Without markets, every market
+// except options is used; exchanges that support none of the markets are skipped.
+// An error is returned only when no source could be fetched.
+func AggregateGainers(exchanges []Exchange, markets []string, query common.GainersQuery) (AggregatedGainers, error) {
+	var sources []source
+	for _, exchange := range exchanges {
+		for _, market := range exchange.Markets() {
+			if aggregated(market, markets) {
+				sources = append(sources, source{exchange: exchange, market: market})
+			}
+		}
+	}
+
+	results, failures := fetchSources(sources)
+
+	var tickers []common.Ticker
+	errs := make(map[string]string)
+	failed := 0
+	for i, s := range sources {
+		if failures[i] != nil {
+			errs[s.String()] = failures[i].Error()
+		}
+		if fatal(failures[i]) {
+			failed++
+			continue
+		}
+		tickers = append(tickers, results[i]...)
+	}
+	if len(sources) > 0 && failed == len(sources) {
+		return AggregatedGainers{}, fmt.Errorf("fetching tickers failed for every exchange: %v", errs)
+	}
+
+	result := AggregatedGainers{Tickers: common.Gainers(tickers, query)}
+	if len(errs) > 0 {
+		result.Errors = errs
+	}
+	return result, nil
+}
+
+// aggregated reports whether the market is one of markets, or not an option market
+// when markets is empty.
+func aggregated(market string, markets []string) bool {
+	if len(markets) == 0 {
+		return market != optionMarket
+	}
+	return slices.Contains(markets, market)
+}
+
+// fetchSources fetches the normalized tickers of every source concurrently.
+// Results and errors are returned in source order.
+func fetchSources(sources []source) ([][]common.Ticker, []error) {
+	results := make([][]common.Ticker, len(sources))
+	failures := make([]error, len(sources))
+
+	var wg sync.WaitGroup
+	for i, s := range sources {
+		wg.Add(1)
+		go func(i int, s source) {
+			defer wg.Done()
+			results[i], failures[i] = s.exchange.NormalizedTickers(s.market)
+		}(i, s)
+	}
+	wg.Wait()
+
+	return results, failures
+}
+
+// Spreads compares the tickers of the same market on two exchanges and returns
+// the price spread of every pair listed on both, largest gap first. When an exchange
+// could only fetch some of its tickers, the spreads of the others are returned with
+// a *common.PartialError keyed by "exchange/symbol".
+func Spreads(first, second Exchange, market string) ([]common.Spread, error) {
+	sources := []source{{exchange: first, market: market}, {exchange: second, market: market}}
+	results, failures := fetchSources(sources)
+	missing := make(map[string]string)
+	for i, s := range sources {
+		if fatal(failures[i]) {
+			return nil, fmt.Errorf("fetching %s tickers failed: %v", s, failures[i])
+		}
+		var partial *common.PartialError
+		if errors.As(failures[i], &partial) {
+			for symbol, err := range partial.Errors {
+				missing[s.exchange.Name()+"/"+symbol] = err
+			}
+		}
+	}
+
+	spreads := common.Spreads(results[0], results[1])
+	if len(missing) > 0 {
+		return spreads, &common.PartialError{Errors: missing}
+	}
+	return spreads, nil
+}
+
+// fatal reports whether err is an error other than a *common.PartialError, which comes
+// with the tickers that could be fetched.
+func fatal(err error) bool {
+	var partial *common.PartialError
+	return err != nil && !errors.As(err, &partial)
+}
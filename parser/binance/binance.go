@@ -4,25 +4,42 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"sort"
-	"strconv"
-	"strings"
+	"net/url"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
 )
 
 const (
-	baseURL = "https://api.binance.com/api/v3"
+	spotBaseURL    = "https://api.binance.com/api/v3"
+	linearBaseURL  = "https://fapi.binance.com/fapi/v1"
+	inverseBaseURL = "https://dapi.binance.com/dapi/v1"
+	// symbolsTTL is how long exchangeInfo metadata is cached.
+	symbolsTTL = time.Hour
 )
 
-type PairListResponse struct {
-	Pairs         []string `json:"pairs"`
-	RefreshPeriod int      `json:"refresh_period"`
-}
+// Market is a Binance market. The futures markets use the same names as the Bybit
+// categories so that a market can be compared across exchanges.
+type Market string
+
+const (
+	Spot Market = "spot"
+	// Linear is the USD-M futures market.
+	Linear Market = "linear"
+	// Inverse is the COIN-M futures market.
+	Inverse Market = "inverse"
+)
+
+// PairListResponse is the Freqtrade-style pair list returned by GetTickersGainerForPairs.
+type PairListResponse = common.PairListResponse
 
 // Client is a struct representing the Client API client.
 type Client struct {
 	apiKey    string
 	apiSecret string
+	baseURLs  map[Market]string
 	client    *http.Client
+	symbols   *common.SymbolCache
 }
 
 // NewClient creates a new instance of the Client API client.
@@ -30,52 +47,45 @@ func NewClient(apiKey, apiSecret string) *Client {
 	return &Client{
 		apiKey:    apiKey,
 		apiSecret: apiSecret,
-		client:    &http.Client{},
+		baseURLs: map[Market]string{
+			Spot:    spotBaseURL,
+			Linear:  linearBaseURL,
+			Inverse: inverseBaseURL,
+		},
+		client:  &http.Client{},
+		symbols: common.NewSymbolCache(symbolsTTL),
 	}
 }
 
-// Get24HourTickerData returns 24-hour price statistics mapped to TickerData for all trading pairs.
+// Get24HourTickerData returns 24-hour price statistics mapped to TickerData for all spot trading pairs.
 func (c *Client) Get24HourTickerData() ([]TickerData, error) {
-	url := fmt.Sprintf("%s/ticker/24hr", baseURL)
+	return c.Get24HourTickerDataInMarket(Spot)
+}
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
+// Get24HourTickerDataInMarket returns 24-hour price statistics mapped to TickerData for all trading pairs of a market.
+func (c *Client) Get24HourTickerDataInMarket(market Market) ([]TickerData, error) {
+	if !IsValidMarket(market) {
+		return nil, fmt.Errorf("invalid market type: %s", market)
 	}
 
-	req.Header.Set("X-MBX-APIKEY", c.apiKey)
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return c.getTickers(fmt.Sprintf("%s/ticker/24hr", c.baseURLs[market]))
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("HTTP error: %s", resp.Status)
-	}
+// GetTickerForPair returns 24-hour price statistics for a specific spot trading pair.
+func (c *Client) GetTickerForPair(pairSymbol string) (TickerData, error) {
+	return c.GetTickerForPairInMarket(Spot, pairSymbol)
+}
 
-	var data []map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return nil, err
+// GetTickerForPairInMarket returns 24-hour price statistics for a specific trading pair of a market.
+func (c *Client) GetTickerForPairInMarket(market Market, pairSymbol string) (TickerData, error) {
+	if !IsValidMarket(market) {
+		return TickerData{}, fmt.Errorf("invalid market type: %s", market)
 	}
-
-	var tickerData []TickerData
-	for _, item := range data {
-		var ticker TickerData
-		err := mapToBinanceTickerData(item, &ticker)
-		if err != nil {
-			return nil, err
-		}
-		tickerData = append(tickerData, ticker)
+	if err := c.symbolsOrEmpty(market).Validate(pairSymbol); err != nil {
+		return TickerData{}, err
 	}
 
-	return tickerData, nil
-}
-
-// GetTickerForPair returns 24-hour price statistics for a specific trading pair.
-func (c *Client) GetTickerForPair(pairSymbol string) (TickerData, error) {
-	url := fmt.Sprintf("%s/ticker/24hr?symbol=%s", baseURL, pairSymbol)
+	url := fmt.Sprintf("%s/ticker/24hr?symbol=%s", c.baseURLs[market], pairSymbol)
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -94,8 +104,12 @@ func (c *Client) GetTickerForPair(pairSymbol string) (TickerData, error) {
 		return TickerData{}, fmt.Errorf("HTTP error: %s", resp.Status)
 	}
 
-	var data map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+	var body interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return TickerData{}, err
+	}
+	data, err := singleTicker(body)
+	if err != nil {
 		return TickerData{}, err
 	}
 
@@ -108,209 +122,208 @@ func (c *Client) GetTickerForPair(pairSymbol string) (TickerData, error) {
 	return ticker, nil
 }
 
-// GetTickersForPairs returns 24-hour price statistics for specific trading pairs.
+// GetTickersForPairs returns 24-hour price statistics for specific spot trading pairs.
 func (c *Client) GetTickersForPairs(pairSymbols []string) ([]TickerData, error) {
-	var tickerDataSlice []TickerData
-
-	for _, pairSymbol := range pairSymbols {
-		url := fmt.Sprintf("%s/ticker/24hr?symbol=%s", baseURL, pairSymbol)
-
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-MBX-APIKEY", c.apiKey)
+	return c.GetTickersForPairsInMarket(Spot, pairSymbols)
+}
 
-		resp, err := c.client.Do(req)
-		if err != nil {
-			return nil, err
-		}
-		defer resp.Body.Close()
+// GetTickersForPairsInMarket returns 24-hour price statistics for specific trading pairs of a market.
+// It fails with ErrUnknownSymbol when a pair is not listed; see GetTickersBatch.
+func (c *Client) GetTickersForPairsInMarket(market Market, pairSymbols []string) ([]TickerData, error) {
+	tickers, errs, err := c.GetTickersBatch(market, pairSymbols)
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%w: %s", common.ErrUnknownSymbol, errs[0].Symbol)
+	}
+	return tickers, nil
+}
 
-		if resp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("HTTP error: %s", resp.Status)
-		}
+// GetTickersBatch returns 24-hour price statistics for several trading pairs of a market
+// from a single request, in request order, and an error for every pair the market does
+// not list. Spot pairs are requested with the symbols parameter; the futures markets do
+// not support it, so their full ticker list is filtered instead.
+func (c *Client) GetTickersBatch(market Market, pairSymbols []string) ([]TickerData, []common.SymbolError, error) {
+	if !IsValidMarket(market) {
+		return nil, nil, fmt.Errorf("invalid market type: %s", market)
+	}
 
-		var data map[string]interface{}
-		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-			return nil, err
+	// Binance rejects the whole request when one symbol is invalid, so symbols missing
+	// from the metadata are reported up front.
+	symbols := c.symbolsOrEmpty(market)
+	var known []string
+	var errs []common.SymbolError
+	for _, pairSymbol := range common.UniqueSymbols(pairSymbols) {
+		if err := symbols.Validate(pairSymbol); err != nil {
+			errs = append(errs, common.UnknownSymbol(pairSymbol))
+			continue
 		}
+		known = append(known, pairSymbol)
+	}
+	if len(known) == 0 {
+		return []TickerData{}, errs, nil
+	}
 
-		var ticker TickerData
-		err = mapToBinanceTickerData(data, &ticker)
+	endpoint := fmt.Sprintf("%s/ticker/24hr", c.baseURLs[market])
+	if market == Spot {
+		encoded, err := json.Marshal(known)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-
-		tickerDataSlice = append(tickerDataSlice, ticker)
+		endpoint += "?symbols=" + url.QueryEscape(string(encoded))
+	}
+	data, err := c.getTickers(endpoint)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return tickerDataSlice, nil
+	tickers, missing := common.PickSymbols(data, func(t TickerData) string { return t.Symbol }, known)
+	return tickers, append(errs, missing...), nil
 }
 
-// Get24HourGainersTickerData returns all trading pairs with a positive price change percent
-// of more than +2% over the last 24 hours, sorted by performance (descending order).
-func (c *Client) Get24HourGainersTickerData(limit int, endingFilter string) ([]TickerData, error) {
-	allTickers, err := c.Get24HourTickerData()
+// getTickers requests a ticker list endpoint and maps the response to TickerData.
+func (c *Client) getTickers(endpoint string) ([]TickerData, error) {
+	req, err := http.NewRequest("GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter profitable pairs.
-	gainerPairs := filterProfitablePairs(allTickers)
-
-	// Apply the ending filter.
-	filteredPairs := filterPairsByEnding(gainerPairs, endingFilter)
-
-	// Apply the exclude filter.
-	finalPairs := make([]TickerData, 0)
-	for _, pair := range filteredPairs {
-
-		finalPairs = append(finalPairs, pair)
+	req.Header.Set("X-MBX-APIKEY", c.apiKey)
 
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// Sort the pairs by performance.
-	sortPairsByPerformance(finalPairs)
-
-	// Apply the limit if specified
-	if limit > 0 && limit < len(filteredPairs) {
-		filteredPairs = filteredPairs[:limit]
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP error: %s", resp.Status)
 	}
 
-	return filteredPairs, nil
-}
-
-// GetTickersGainerForPairs returns formatted trading pair symbols as strings.
-func (c *Client) GetTickersGainerForPairs(limit int, endingFilter, excludeFilter string) (PairListResponse, error) {
-	// First, fetch all tickers.
-	allTickers, err := c.Get24HourTickerData()
-	if err != nil {
-		return PairListResponse{}, err
+	var data []map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
 	}
 
-	// Filter profitable pairs.
-	gainerPairs := filterProfitablePairs(allTickers)
-
-	// Apply the ending filter.
-	filteredPairs := filterPairsByEnding(gainerPairs, endingFilter)
-
-	// Apply the exclude filter.
-	finalPairs := make([]TickerData, 0)
-	for _, pair := range filteredPairs {
-		if !strings.Contains(pair.Symbol, excludeFilter) {
-			finalPairs = append(finalPairs, pair)
+	var tickerData []TickerData
+	for _, item := range data {
+		var ticker TickerData
+		err := mapToBinanceTickerData(item, &ticker)
+		if err != nil {
+			return nil, err
 		}
+		tickerData = append(tickerData, ticker)
 	}
 
-	// Sort the pairs by performance.
-	sortPairsByPerformance(finalPairs)
+	return tickerData, nil
+}
 
-	// Extract the symbols and apply the limit.
-	tradingPairSymbols := extractTradingPairSymbols(finalPairs, limit)
+// Get24HourGainersTickerData returns all spot trading pairs with a positive price change percent
+// over the last 24 hours, sorted by performance (descending order).
+func (c *Client) Get24HourGainersTickerData(limit int, endingFilter string) ([]TickerData, error) {
+	return c.Get24HourGainersTickerDataInMarket(Spot, limit, endingFilter)
+}
 
-	// Format the pairs.
-	formattedPairs := formatPairs(tradingPairSymbols, endingFilter)
-	response := PairListResponse{
-		Pairs:         formattedPairs,
-		RefreshPeriod: 43200, //12h
-	}
-	return response, nil
+// Get24HourGainersTickerDataInMarket returns the gainers of a market like Get24HourGainersTickerData.
+func (c *Client) Get24HourGainersTickerDataInMarket(market Market, limit int, endingFilter string) ([]TickerData, error) {
+	return c.rank(market, common.RankingGainers, common.GainersQuery{Limit: limit, EndingFilter: endingFilter})
 }
 
-// formatPairs takes a slice of symbols and appends a "/" between the base currency and the endingFilter.
-func formatPairs(symbols []string, endingFilter string) []string {
-	var formattedPairs []string
-	for _, symbol := range symbols {
-		// Trim the endingFilter from the symbol and add it back with a "/" for formatting.
-		base := strings.TrimSuffix(symbol, endingFilter)
-		formattedPair := base + "/" + endingFilter
-		formattedPairs = append(formattedPairs, formattedPair)
-	}
-	return formattedPairs
+// GetTickersGainerForPairs returns formatted spot trading pair symbols as strings.
+func (c *Client) GetTickersGainerForPairs(limit int, endingFilter, excludeFilter string) (PairListResponse, error) {
+	return c.GetTickersGainerForPairsInMarket(Spot, limit, endingFilter, excludeFilter)
+}
+
+// GetTickersGainerForPairsInMarket returns formatted trading pair symbols of a market as strings.
+func (c *Client) GetTickersGainerForPairsInMarket(market Market, limit int, endingFilter, excludeFilter string) (PairListResponse, error) {
+	query := common.GainersQuery{Limit: limit, EndingFilter: endingFilter, ExcludeFilter: excludeFilter}
+	return c.rankPairs(market, common.RankingGainers, query)
 }
 
-// Function to filter profitable pairs
-func filterProfitablePairs(data []TickerData) []TickerData {
-	var profitablePairs []TickerData
+// rank ranks the normalized tickers of the market and returns the matching native tickers,
+// ranking over the rolling window of the query when it has one.
+func (c *Client) rank(market Market, ranking common.Ranking, query common.GainersQuery) ([]TickerData, error) {
+	if query.Window != "" {
+		return c.rankWindow(market, ranking, query)
+	}
 
-	for _, pair := range data {
-		priceChangePercent := pair.PriceChangePercent
-		if priceChangePercent[0] != '-' {
-			profitablePairs = append(profitablePairs, pair)
-		}
+	data, err := c.Get24HourTickerDataInMarket(market)
+	if err != nil {
+		return nil, err
 	}
 
-	return profitablePairs
+	ranked := common.Rank(c.toTickers(market, data), ranking, query)
+	return common.Pick(data, func(t TickerData) string { return t.Symbol }, ranked), nil
 }
 
-// FilterPairsByEnding filters pairs by ending if endingFilter is provided.
-func filterPairsByEnding(data []TickerData, endingFilter string) []TickerData {
-	var filteredPairs []TickerData
-
-	for _, pair := range data {
-		if strings.HasSuffix(pair.Symbol, endingFilter) {
-			filteredPairs = append(filteredPairs, pair)
+// rankPairs ranks the normalized tickers of the market and formats them as "BASE/QUOTE" pairs.
+func (c *Client) rankPairs(market Market, ranking common.Ranking, query common.GainersQuery) (PairListResponse, error) {
+	if query.Window != "" {
+		_, ranked, err := c.windowTickers(market, ranking, query)
+		if err != nil {
+			return PairListResponse{}, err
 		}
+		return common.PairList(ranked), nil
 	}
 
-	return filteredPairs
+	data, err := c.Get24HourTickerDataInMarket(market)
+	if err != nil {
+		return PairListResponse{}, err
+	}
+	return common.PairList(common.Rank(c.toTickers(market, data), ranking, query)), nil
 }
 
-// Updated sorting function to sort based on the price change percent.
-func sortPairsByPerformance(data []TickerData) {
-	sort.Slice(data, func(i, j int) bool {
-		// Convert price change percent to float for sorting.
-		percentI, _ := strconv.ParseFloat(strings.TrimSuffix(data[i].PriceChangePercent, "%"), 64)
-		percentJ, _ := strconv.ParseFloat(strings.TrimSuffix(data[j].PriceChangePercent, "%"), 64)
-		return percentI > percentJ
-	})
+// toTickers converts native tickers of the market and enriches them with the market metadata.
+func (c *Client) toTickers(market Market, data []TickerData) []common.Ticker {
+	tickers := toTickers(market, data)
+	c.symbolsOrEmpty(market).Enrich(tickers)
+	return tickers
 }
 
-func extractTradingPairSymbols(data []TickerData, limit int) []string {
-	var symbols []string
-	for i, pair := range data {
-		if limit > 0 && i >= limit {
-			break
-		}
-		symbols = append(symbols, pair.Symbol)
-	}
-	return symbols
+// FilterPairsEndingWith returns spot pairs quoted in the specified ending, a comma-separated
+// list of quote assets such as "USDT,USDC".
+func (c *Client) FilterPairsEndingWith(ending string) ([]string, error) {
+	return c.FilterPairsEndingWithInMarket(Spot, ending)
 }
 
-// FilterPairsEndingWith returns pairs that end with the specified ending.
-func (c *Client) FilterPairsEndingWith(ending string) ([]string, error) {
-	tickerData, err := c.Get24HourTickerData()
+// FilterPairsEndingWithInMarket returns the pairs of a market quoted in the specified ending.
+func (c *Client) FilterPairsEndingWithInMarket(market Market, ending string) ([]string, error) {
+	tickerData, err := c.Get24HourTickerDataInMarket(market)
 	if err != nil {
 		return nil, err
 	}
 
+	symbols := c.symbolsOrEmpty(market)
+	quotes := common.SplitList(ending)
 	var filteredPairs []string
 
 	for _, data := range tickerData {
-		if strings.HasSuffix(data.Symbol, ending) {
-			filteredPairs = append(filteredPairs, data.Symbol)
+		for _, quote := range quotes {
+			if symbols.HasQuote(data.Symbol, quote) {
+				filteredPairs = append(filteredPairs, data.Symbol)
+				break
+			}
 		}
 	}
 
 	return filteredPairs, nil
 }
 
-// HasMoreThanTwoConsecutiveZeros checks if a string has more than two consecutive zeros.
-func hasMoreThanTwoConsecutiveZeros(s string) bool {
-	count := 0
-	for _, char := range s {
-		if char == '0' {
-			count++
-			if count > 2 {
-				return true
+// singleTicker returns the ticker object of a single-symbol response. COIN-M futures
+// answer with a one-element array instead of an object.
+func singleTicker(body interface{}) (map[string]interface{}, error) {
+	switch v := body.(type) {
+	case map[string]interface{}:
+		return v, nil
+	case []interface{}:
+		if len(v) > 0 {
+			if data, ok := v[0].(map[string]interface{}); ok {
+				return data, nil
 			}
-		} else {
-			count = 0
 		}
 	}
-	return false
+	return nil, fmt.Errorf("unexpected ticker response")
 }
 
 // mapToBinanceTickerData maps JSON data to TickerData struct.
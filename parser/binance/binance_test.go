@@ -1,10 +1,18 @@
 package binance
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
 )
 
 func TestGet24HourTickerDataForPair(t *testing.T) {
@@ -106,3 +114,333 @@ func TestGet24HourTickerData(t *testing.T) {
 	}
 
 }
+
+func TestGetTickerForPairCoinM(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var jsonResponse string
+		switch r.URL.Path {
+		case "/dapi/v1/exchangeInfo":
+			jsonResponse = `{"symbols":[{"symbol":"BTCUSD_PERP","pair":"BTCUSD","contractStatus":"TRADING","baseAsset":"BTC","quoteAsset":"USD"}]}`
+		case "/dapi/v1/ticker/24hr":
+			jsonResponse = `[{
+				"symbol": "BTCUSD_PERP",
+				"pair": "BTCUSD",
+				"priceChangePercent": "2.5",
+				"lastPrice": "102.5",
+				"openPrice": "100",
+				"weightedAvgPrice": "101",
+				"volume": "1000",
+				"baseVolume": "10"
+			}]`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(jsonResponse))
+	}))
+	defer server.Close()
+
+	binanceClient := NewClient("", "")
+	binanceClient.client = server.Client()
+	binanceClient.baseURLs[Inverse] = server.URL + "/dapi/v1"
+
+	tickerData, err := binanceClient.GetTickerForPairInMarket(Inverse, "BTCUSD_PERP")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if tickerData.Symbol != "BTCUSD_PERP" {
+		t.Errorf("Expected symbol 'BTCUSD_PERP', but got %s", tickerData.Symbol)
+	}
+
+	ticker := tickerData.ToTicker(Inverse)
+	if ticker.BaseAsset != "BTC" || ticker.QuoteAsset != "USD" || ticker.QuoteVolume != 1010 {
+		t.Errorf("Expected BTC/USD with a quote volume of 1010, but got %+v", ticker)
+	}
+}
+
+func TestGetTickersBatch(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var jsonResponse string
+		switch r.URL.Path {
+		case "/api/v3/exchangeInfo":
+			jsonResponse = `{"symbols":[
+				{"symbol":"BTCUSDT","status":"TRADING","baseAsset":"BTC","quoteAsset":"USDT"},
+				{"symbol":"ETHUSDT","status":"TRADING","baseAsset":"ETH","quoteAsset":"USDT"}
+			]}`
+		case "/api/v3/ticker/24hr":
+			requested = r.URL.Query().Get("symbols")
+			jsonResponse = `[
+				{"symbol": "ETHUSDT", "priceChangePercent": "1.5", "lastPrice": "3000"},
+				{"symbol": "BTCUSDT", "priceChangePercent": "2.5", "lastPrice": "50000"}
+			]`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(jsonResponse))
+	}))
+	defer server.Close()
+
+	binanceClient := NewClient("", "")
+	binanceClient.client = server.Client()
+	binanceClient.baseURLs[Spot] = server.URL + "/api/v3"
+
+	tickers, errs, err := binanceClient.GetTickersBatch(Spot, []string{"btcusdt", "NOPEUSDT", "ETHUSDT", "BTCUSDT"})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if requested != `["BTCUSDT","ETHUSDT"]` {
+		t.Errorf("Expected one request for the known symbols, but got %s", requested)
+	}
+	if len(tickers) != 2 || tickers[0].Symbol != "BTCUSDT" || tickers[1].Symbol != "ETHUSDT" {
+		t.Errorf("Expected BTCUSDT and ETHUSDT in request order, but got %+v", tickers)
+	}
+	if len(errs) != 1 || errs[0].Symbol != "NOPEUSDT" {
+		t.Errorf("Expected an error for NOPEUSDT, but got %+v", errs)
+	}
+
+	if _, err := binanceClient.GetTickersForPairs([]string{"BTCUSDT", "NOPEUSDT"}); !errors.Is(err, common.ErrUnknownSymbol) {
+		t.Errorf("Expected ErrUnknownSymbol, but got %v", err)
+	}
+}
+
+func TestRankWindow(t *testing.T) {
+	var windowSize, requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var jsonResponse string
+		switch r.URL.Path {
+		case "/api/v3/exchangeInfo":
+			jsonResponse = `{"symbols":[
+				{"symbol":"BTCUSDT","status":"TRADING","baseAsset":"BTC","quoteAsset":"USDT"},
+				{"symbol":"ETHUSDT","status":"TRADING","baseAsset":"ETH","quoteAsset":"USDT"},
+				{"symbol":"ETHBTC","status":"TRADING","baseAsset":"ETH","quoteAsset":"BTC"}
+			]}`
+		case "/api/v3/ticker/24hr":
+			jsonResponse = `[
+				{"symbol": "BTCUSDT", "priceChangePercent": "5", "lastPrice": "50000", "quoteVolume": "1000000"},
+				{"symbol": "ETHUSDT", "priceChangePercent": "-1", "lastPrice": "3000", "quoteVolume": "1000000"},
+				{"symbol": "ETHBTC", "priceChangePercent": "3", "lastPrice": "0.06", "quoteVolume": "100"}
+			]`
+		case "/api/v3/ticker":
+			windowSize = r.URL.Query().Get("windowSize")
+			requested = r.URL.Query().Get("symbols")
+			jsonResponse = `[
+				{"symbol": "BTCUSDT", "priceChangePercent": "-0.5", "openPrice": "50250", "lastPrice": "50000", "quoteVolume": "40000"},
+				{"symbol": "ETHUSDT", "priceChangePercent": "2", "openPrice": "2941", "lastPrice": "3000", "quoteVolume": "30000"}
+			]`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(jsonResponse))
+	}))
+	defer server.Close()
+
+	binanceClient := NewClient("", "")
+	binanceClient.client = server.Client()
+	binanceClient.baseURLs[Spot] = server.URL + "/api/v3"
+
+	query := common.GainersQuery{EndingFilter: "USDT", Window: common.Window1h}
+	pairs, err := binanceClient.RankPairs(string(Spot), common.RankingGainers, query)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if windowSize != "1h" || requested != `["BTCUSDT","ETHUSDT"]` {
+		t.Errorf("Expected a 1h window request for the USDT pairs, but got windowSize %s and symbols %s", windowSize, requested)
+	}
+	if len(pairs.Pairs) != 1 || pairs.Pairs[0] != "ETH/USDT" {
+		t.Errorf("Expected ETH/USDT, the only gainer over the window, but got %v", pairs.Pairs)
+	}
+
+	if binanceClient.SupportsWindow(string(Linear), common.Window1h) {
+		t.Errorf("Expected no window support in the linear market")
+	}
+	if _, err := binanceClient.GetWindowTickerData(Linear, common.Window1h, []string{"BTCUSDT"}); !errors.Is(err, common.ErrUnsupportedWindow) {
+		t.Errorf("Expected ErrUnsupportedWindow, but got %v", err)
+	}
+}
+
+func TestGetKlines(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/klines" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		query := r.URL.Query()
+		if query.Get("symbol") != "BTCUSDT" || query.Get("interval") != "1h" {
+			t.Errorf("Expected 1h klines of BTCUSDT, but got %s of %s", query.Get("interval"), query.Get("symbol"))
+		}
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		if limit > klinesLimit {
+			t.Errorf("Expected at most %d klines per request, but got %d", klinesLimit, limit)
+		}
+
+		// The history has 1500 hourly klines; the latest ones up to endTime are returned.
+		last := 1499
+		if endTime := query.Get("endTime"); endTime != "" {
+			millis, _ := strconv.ParseInt(endTime, 10, 64)
+			last = min(last, int(time.UnixMilli(millis).Sub(start)/time.Hour))
+		}
+		var rows []string
+		for i := max(0, last-limit+1); i <= last; i++ {
+			openTime := start.Add(time.Duration(i) * time.Hour).UnixMilli()
+			rows = append(rows, fmt.Sprintf(`[%d,"%d","%d.5","%d","%d","2",%d,"20",5,"1","10","0"]`, openTime, i, i, i, i, openTime+3599999))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("[" + strings.Join(rows, ",") + "]"))
+	}))
+	defer server.Close()
+
+	binanceClient := NewClient("", "")
+	binanceClient.client = server.Client()
+	binanceClient.baseURLs[Spot] = server.URL + "/api/v3"
+
+	candles, err := binanceClient.GetKlines(Spot, "BTCUSDT", common.CandleQuery{Interval: common.Interval1h, Limit: 1200})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, but got %d", requests)
+	}
+	if len(candles) != 1200 || candles[0].Open != 300 || candles[1199].Open != 1499 {
+		t.Fatalf("Expected the latest 1200 klines oldest first, but got %d from %v", len(candles), candles[0].Open)
+	}
+	if candles[0].High != 300.5 || candles[0].QuoteVolume != 20 || !candles[0].OpenTime.Equal(start.Add(300*time.Hour)) {
+		t.Errorf("Expected the fields of kline 300, but got %+v", candles[0])
+	}
+
+	if _, err := binanceClient.GetKlines(Spot, "BTCUSDT", common.CandleQuery{Interval: "2m"}); err == nil {
+		t.Errorf("Expected an error for an invalid interval")
+	}
+}
+
+func TestGetOrderBook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/dapi/v1/exchangeInfo" {
+			w.Write([]byte(`{"symbols":[{"symbol":"BTCUSD_PERP","contractStatus":"TRADING","contractSize":100,"baseAsset":"BTC","quoteAsset":"USD"},
+				{"symbol":"NEWUSD_PERP","contractStatus":"TRADING","baseAsset":"NEW","quoteAsset":"USD"}]}`))
+			return
+		}
+		if r.URL.Path != "/api/v3/depth" && r.URL.Path != "/fapi/v1/depth" && r.URL.Path != "/dapi/v1/depth" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query := r.URL.Query()
+		if r.URL.Path == "/fapi/v1/depth" && query.Get("limit") != "50" {
+			t.Errorf("Expected the futures limit to be raised to 50, but got %s", query.Get("limit"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"lastUpdateId":1027024,"bids":[["99.90","10"],["99.50","20"]],"asks":[["100.10","10"],["100.50","20"]]}`))
+	}))
+	defer server.Close()
+
+	binanceClient := NewClient("", "")
+	binanceClient.client = server.Client()
+	binanceClient.baseURLs[Spot] = server.URL + "/api/v3"
+	binanceClient.baseURLs[Linear] = server.URL + "/fapi/v1"
+	binanceClient.baseURLs[Inverse] = server.URL + "/dapi/v1"
+
+	book, err := binanceClient.GetOrderBook(Spot, "BTCUSDT", 100)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if book.Symbol != "BTCUSDT" || len(book.Bids) != 2 || len(book.Asks) != 2 {
+		t.Fatalf("Expected 2 levels per side of BTCUSDT, but got %+v", book)
+	}
+	if book.Bids[0] != (common.Level{Price: 99.9, Quantity: 10}) || book.Asks[1] != (common.Level{Price: 100.5, Quantity: 20}) {
+		t.Errorf("Expected the levels of the response, but got %+v", book)
+	}
+
+	if _, err := binanceClient.GetOrderBook(Linear, "BTCUSDT", 30); err != nil {
+		t.Errorf("Expected no error, but got %v", err)
+	}
+
+	inverse, err := binanceClient.GetOrderBook(Inverse, "BTCUSD_PERP", 30)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if math.Abs(inverse.Bids[0].Quantity*99.9-1000) > 1e-9 || math.Abs(inverse.Asks[1].Quantity*100.5-2000) > 1e-9 {
+		t.Errorf("Expected the contract counts in BTC, but got %+v", inverse)
+	}
+	if _, err := binanceClient.GetOrderBook(Inverse, "NEWUSD_PERP", 30); err == nil {
+		t.Errorf("Expected an error without a contract size")
+	}
+}
+
+func TestGetTrades(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var jsonResponse string
+		switch r.URL.Path {
+		case "/api/v3/trades":
+			if r.URL.Query().Get("limit") != "1000" {
+				t.Errorf("Expected the limit to be capped at 1000, but got %s", r.URL.Query().Get("limit"))
+			}
+			jsonResponse = `[{"id":28457,"price":"100.0","qty":"2","quoteQty":"200","time":1700000000000,"isBuyerMaker":true,"isBestMatch":true},
+				{"id":28458,"price":"101.0","qty":"1","quoteQty":"101","time":1700000060000,"isBuyerMaker":false,"isBestMatch":true}]`
+		case "/api/v3/aggTrades":
+			jsonResponse = `[{"a":26129,"p":"100.5","q":"4","f":27781,"l":27783,"T":1700000000000,"m":false,"M":true}]`
+		case "/dapi/v1/trades":
+			jsonResponse = `[{"id":1,"price":"50000","qty":"10","baseQty":"0.02","time":1700000000000,"isBuyerMaker":false}]`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(jsonResponse))
+	}))
+	defer server.Close()
+
+	binanceClient := NewClient("", "")
+	binanceClient.client = server.Client()
+	binanceClient.baseURLs[Spot] = server.URL + "/api/v3"
+	binanceClient.baseURLs[Inverse] = server.URL + "/dapi/v1"
+
+	trades, err := binanceClient.GetTrades(Spot, "BTCUSDT", common.TradeQuery{Limit: 5000})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(trades) != 2 || trades[0].Side != common.SideSell || trades[1].Side != common.SideBuy {
+		t.Fatalf("Expected a taker sell then a taker buy, but got %+v", trades)
+	}
+	if trades[0].ID != "28457" || trades[0].QuoteQuantity != 200 || !trades[1].Time.Equal(time.UnixMilli(1700000060000)) {
+		t.Errorf("Expected the fields of the response, but got %+v", trades)
+	}
+
+	aggregated, err := binanceClient.GetTrades(Spot, "BTCUSDT", common.TradeQuery{Aggregate: true})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(aggregated) != 1 || aggregated[0].QuoteQuantity != 402 || aggregated[0].Side != common.SideBuy {
+		t.Errorf("Expected one aggregated taker buy of 402, but got %+v", aggregated)
+	}
+
+	inverse, err := binanceClient.GetTrades(Inverse, "BTCUSD_PERP", common.TradeQuery{})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(inverse) != 1 || inverse[0].Quantity != 0.02 || inverse[0].QuoteQuantity != 1000 {
+		t.Errorf("Expected the base quantity of the contracts, but got %+v", inverse)
+	}
+	if _, err := binanceClient.GetTrades(Inverse, "BTCUSD_PERP", common.TradeQuery{Aggregate: true}); !errors.Is(err, common.ErrUnsupportedAggregate) {
+		t.Errorf("Expected ErrUnsupportedAggregate, but got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// spotDepthLimit is the most levels per side of a spot order book.
+const spotDepthLimit = 5000
+
+// futuresDepthLimits are the depth limits the futures markets accept.
+var futuresDepthLimits = []int{5, 10, 20, 50, 100, 500, 1000}
+
+// depthResponse is the order book response; every level is a [price, quantity] pair.
+type depthResponse struct {
+	LastUpdateID int64       `json:"lastUpdateId"`
+	Bids         [][2]string `json:"bids"`
+	Asks         [][2]string `json:"asks"`
+}
+
+// GetOrderBook returns the order book of a trading pair of a market with up to limit
+// levels per side. The futures markets only accept certain limits, so the limit is
+// raised to the next accepted one there. COIN-M order books are sized in contracts, so
+// their quantities are converted to the base asset with the contract size from the
+// market metadata, and the book is not served when the contract size is unknown.
+func (c *Client) GetOrderBook(market Market, pairSymbol string, limit int) (common.OrderBook, error) {
+	if !IsValidMarket(market) {
+		return common.OrderBook{}, fmt.Errorf("invalid market type: %s", market)
+	}
+	symbols := c.symbolsOrEmpty(market)
+	if err := symbols.Validate(pairSymbol); err != nil {
+		return common.OrderBook{}, err
+	}
+	var contractSize float64
+	if market == Inverse {
+		contractSize = symbols[pairSymbol].ContractSize
+		if contractSize <= 0 {
+			return common.OrderBook{}, fmt.Errorf("contract size of %s is unknown", pairSymbol)
+		}
+	}
+
+	query := url.Values{}
+	query.Set("symbol", pairSymbol)
+	query.Set("limit", strconv.Itoa(depthLimit(market, limit)))
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/depth?%s", c.baseURLs[market], query.Encode()), nil)
+	if err != nil {
+		return common.OrderBook{}, err
+	}
+
+	req.Header.Set("X-MBX-APIKEY", c.apiKey)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return common.OrderBook{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return common.OrderBook{}, fmt.Errorf("HTTP error: %s", resp.Status)
+	}
+
+	var depth depthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&depth); err != nil {
+		return common.OrderBook{}, err
+	}
+
+	return common.OrderBook{
+		Exchange: Name,
+		Market:   string(market),
+		Symbol:   pairSymbol,
+		Bids:     levels(depth.Bids, contractSize),
+		Asks:     levels(depth.Asks, contractSize),
+		Time:     time.Now().UTC(),
+	}, nil
+}
+
+// depthLimit returns the limit to request for up to limit levels per side of the market.
+func depthLimit(market Market, limit int) int {
+	if market == Spot {
+		return max(1, min(limit, spotDepthLimit))
+	}
+	for _, accepted := range futuresDepthLimits {
+		if accepted >= limit {
+			return accepted
+		}
+	}
+	return futuresDepthLimits[len(futuresDepthLimits)-1]
+}
+
+// levels converts [price, quantity] pairs to order book levels. A positive contractSize
+// is the quote value of one contract of a COIN-M book, whose quantities are contract
+// counts converted to the base asset.
+func levels(pairs [][2]string, contractSize float64) []common.Level {
+	out := make([]common.Level, 0, len(pairs))
+	for _, pair := range pairs {
+		price, quantity := common.ParseFloat(pair[0]), common.ParseFloat(pair[1])
+		if contractSize > 0 && price > 0 {
+			quantity *= contractSize / price
+		} else if contractSize > 0 {
+			quantity = 0
+		}
+		out = append(out, common.Level{Price: price, Quantity: quantity})
+	}
+	return out
+}
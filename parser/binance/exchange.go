@@ -0,0 +1,72 @@
+package binance
+
+import (
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// Name is the key the Binance client is registered under.
+const Name = "binance"
+
+// Name returns the exchange name.
+func (c *Client) Name() string {
+	return Name
+}
+
+// Markets returns the market types supported by the client, spot first.
+func (c *Client) Markets() []string {
+	return []string{string(Spot), string(Linear), string(Inverse)}
+}
+
+// Tickers returns 24-hour ticker data for all trading pairs in the given market.
+func (c *Client) Tickers(market string) (interface{}, error) {
+	return c.Get24HourTickerDataInMarket(Market(market))
+}
+
+// Ticker returns 24-hour ticker data for a single trading pair in the given market.
+func (c *Client) Ticker(market, pair string) (interface{}, error) {
+	return c.GetTickerForPairInMarket(Market(market), pair)
+}
+
+// TickersFor returns 24-hour ticker data for the given trading pairs of the market from a
+// single request, and an error for every pair the market does not list.
+func (c *Client) TickersFor(market string, symbols []string) (interface{}, []common.SymbolError, error) {
+	return c.GetTickersBatch(Market(market), symbols)
+}
+
+// Candles returns the candles of a trading pair of the given market selected by the query.
+func (c *Client) Candles(market, pair string, query common.CandleQuery) ([]common.Candle, error) {
+	return c.GetKlines(Market(market), pair, query)
+}
+
+// OrderBook returns the order book of a trading pair of the given market with up to
+// limit levels per side.
+func (c *Client) OrderBook(market, pair string, limit int) (common.OrderBook, error) {
+	return c.GetOrderBook(Market(market), pair, limit)
+}
+
+// Trades returns the most recent trades of a trading pair of the given market, oldest first.
+func (c *Client) Trades(market, pair string, query common.TradeQuery) ([]common.Trade, error) {
+	return c.GetTrades(Market(market), pair, query)
+}
+
+// Rank returns the trading pairs of the given market kept and ordered by the ranking.
+func (c *Client) Rank(market string, ranking common.Ranking, query common.GainersQuery) (interface{}, error) {
+	return c.rank(Market(market), ranking, query)
+}
+
+// RankPairs returns the ranked trading pairs of the given market as a pair list.
+func (c *Client) RankPairs(market string, ranking common.Ranking, query common.GainersQuery) (common.PairListResponse, error) {
+	return c.rankPairs(Market(market), ranking, query)
+}
+
+// NormalizedTickers returns 24-hour ticker data for all trading pairs in the given market
+// converted to the exchange-agnostic Ticker.
+func (c *Client) NormalizedTickers(market string) ([]common.Ticker, error) {
+	m := Market(market)
+	data, err := c.Get24HourTickerDataInMarket(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.toTickers(m, data), nil
+}
@@ -1 +1,63 @@
 package binance
+
+import (
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// IsValidMarket checks if the provided market is valid.
+func IsValidMarket(m Market) bool {
+	switch m {
+	case Spot, Linear, Inverse:
+		return true
+	default:
+		return false
+	}
+}
+
+// ToTicker converts the Binance ticker of the given market to the exchange-agnostic Ticker.
+// Binance already reports priceChangePercent in percent. COIN-M volumes are in contracts,
+// so the base volume is used and the quote volume is estimated from the weighted average price.
+func (t TickerData) ToTicker(market Market) common.Ticker {
+	base, quote := common.SplitSymbol(t.Symbol)
+	if t.Pair != "" {
+		base, quote = common.SplitSymbol(t.Pair)
+	}
+	volume := common.ParseFloat(t.Volume)
+	quoteVolume := common.ParseFloat(t.QuoteVolume)
+	if market == Inverse {
+		volume = common.ParseFloat(t.BaseVolume)
+		quoteVolume = volume * common.ParseFloat(t.WeightedAvgPrice)
+	}
+	return common.Ticker{
+		Exchange:      Name,
+		Market:        string(market),
+		Symbol:        t.Symbol,
+		BaseAsset:     base,
+		QuoteAsset:    quote,
+		LastPrice:     common.ParseFloat(t.LastPrice),
+		OpenPrice:     common.ParseFloat(t.OpenPrice),
+		HighPrice:     common.ParseFloat(t.HighPrice),
+		LowPrice:      common.ParseFloat(t.LowPrice),
+		BidPrice:      common.ParseFloat(t.BidPrice),
+		BidQuantity:   common.ParseFloat(t.BidQuantity),
+		AskPrice:      common.ParseFloat(t.AskPrice),
+		AskQuantity:   common.ParseFloat(t.AskQuantity),
+		Volume:        volume,
+		QuoteVolume:   quoteVolume,
+		ChangePercent: common.ParseFloat(t.PriceChangePercent),
+		TradeCount:    int64(t.TradeCount),
+		OpenTime:      time.UnixMilli(int64(t.OpenTime)).UTC(),
+		CloseTime:     time.UnixMilli(int64(t.CloseTime)).UTC(),
+	}
+}
+
+// toTickers converts a slice of Binance tickers of a market to exchange-agnostic tickers.
+func toTickers(market Market, data []TickerData) []common.Ticker {
+	tickers := make([]common.Ticker, 0, len(data))
+	for _, t := range data {
+		tickers = append(tickers, t.ToTicker(market))
+	}
+	return tickers
+}
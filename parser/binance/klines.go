@@ -0,0 +1,92 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// klinesLimit is the most klines Binance returns per request.
+const klinesLimit = 1000
+
+// GetKlines returns the candles of a trading pair of a market selected by the query,
+// oldest first. Queries for more than klinesLimit candles are paged through.
+func (c *Client) GetKlines(market Market, pairSymbol string, query common.CandleQuery) ([]common.Candle, error) {
+	if !IsValidMarket(market) {
+		return nil, fmt.Errorf("invalid market type: %s", market)
+	}
+	if !common.IsValidInterval(query.Interval) {
+		return nil, fmt.Errorf("invalid interval: %s", query.Interval)
+	}
+	if err := c.symbolsOrEmpty(market).Validate(pairSymbol); err != nil {
+		return nil, err
+	}
+
+	return common.FetchCandles(query, klinesLimit, func(end time.Time, limit int) ([]common.Candle, error) {
+		return c.klines(market, pairSymbol, query.Interval, end, limit)
+	})
+}
+
+// klines requests at most limit klines of a trading pair opening at or before end, or
+// the latest ones when end is zero. Every kline is an array of
+// [openTime, open, high, low, close, volume, closeTime, quoteVolume, ...].
+func (c *Client) klines(market Market, pairSymbol string, interval common.Interval, end time.Time, limit int) ([]common.Candle, error) {
+	query := url.Values{}
+	query.Set("symbol", pairSymbol)
+	query.Set("interval", string(interval))
+	query.Set("limit", strconv.Itoa(limit))
+	if !end.IsZero() {
+		query.Set("endTime", strconv.FormatInt(end.UnixMilli(), 10))
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/klines?%s", c.baseURLs[market], query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-MBX-APIKEY", c.apiKey)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP error: %s", resp.Status)
+	}
+
+	var rows [][]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&rows); err != nil {
+		return nil, err
+	}
+
+	candles := make([]common.Candle, 0, len(rows))
+	for _, row := range rows {
+		if len(row) < 8 {
+			continue
+		}
+		openTime, _ := row[0].(float64)
+		candles = append(candles, common.Candle{
+			OpenTime:    time.UnixMilli(int64(openTime)).UTC(),
+			Open:        klineFloat(row[1]),
+			High:        klineFloat(row[2]),
+			Low:         klineFloat(row[3]),
+			Close:       klineFloat(row[4]),
+			Volume:      klineFloat(row[5]),
+			QuoteVolume: klineFloat(row[7]),
+		})
+	}
+	return candles, nil
+}
+
+// klineFloat parses a numeric kline field, which Binance reports as a string.
+func klineFloat(value interface{}) float64 {
+	s, _ := value.(string)
+	return common.ParseFloat(s)
+}
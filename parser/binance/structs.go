@@ -1,6 +1,8 @@
 package binance
 
 // TickerData represents the 24-hour ticker data for a trading pair.
+// Futures tickers carry no top of book; COIN-M tickers report the underlying pair and
+// the base asset volume instead of the quote volume.
 type TickerData struct {
 	AskPrice                string  `json:"askPrice"`
 	AskQuantity             string  `json:"askQty"`
@@ -16,12 +18,42 @@ type TickerData struct {
 	LowPrice                string  `json:"lowPrice"`
 	OpenPrice               string  `json:"openPrice"`
 	OpenTime                float64 `json:"openTime"`
+	Pair                    string  `json:"pair,omitempty"`
 	PreviousClosePrice      string  `json:"prevClosePrice"`
 	PriceChange             string  `json:"priceChange"`
 	PriceChangePercent      string  `json:"priceChangePercent"`
 	PriceChangePercentFloat float64
 	QuoteVolume             string `json:"quoteVolume"`
+	BaseVolume              string `json:"baseVolume,omitempty"`
 	Symbol                  string `json:"symbol"`
 	Volume                  string `json:"volume"`
 	WeightedAvgPrice        string `json:"weightedAvgPrice"`
 }
+
+// ExchangeInfo is the response of the exchangeInfo endpoint.
+type ExchangeInfo struct {
+	Timezone   string       `json:"timezone"`
+	ServerTime int64        `json:"serverTime"`
+	Symbols    []SymbolInfo `json:"symbols"`
+}
+
+// SymbolInfo is the exchangeInfo metadata of a trading pair.
+type SymbolInfo struct {
+	Symbol string `json:"symbol"`
+	Status string `json:"status"`
+	// ContractStatus replaces Status for COIN-M futures.
+	ContractStatus string `json:"contractStatus,omitempty"`
+	// ContractSize is the quote value of one COIN-M contract.
+	ContractSize float64        `json:"contractSize,omitempty"`
+	BaseAsset    string         `json:"baseAsset"`
+	QuoteAsset   string         `json:"quoteAsset"`
+	Filters      []SymbolFilter `json:"filters"`
+}
+
+// SymbolFilter is a trading rule of a symbol. Only the fields used by the client are mapped.
+type SymbolFilter struct {
+	FilterType string `json:"filterType"`
+	TickSize   string `json:"tickSize,omitempty"`
+	StepSize   string `json:"stepSize,omitempty"`
+	MinQty     string `json:"minQty,omitempty"`
+}
@@ -0,0 +1,92 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// GetExchangeInfo returns the trading rules and metadata of every symbol of a market.
+func (c *Client) GetExchangeInfo(market Market) (ExchangeInfo, error) {
+	if !IsValidMarket(market) {
+		return ExchangeInfo{}, fmt.Errorf("invalid market type: %s", market)
+	}
+
+	url := fmt.Sprintf("%s/exchangeInfo", c.baseURLs[market])
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return ExchangeInfo{}, err
+	}
+
+	req.Header.Set("X-MBX-APIKEY", c.apiKey)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return ExchangeInfo{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return ExchangeInfo{}, fmt.Errorf("HTTP error: %s", resp.Status)
+	}
+
+	var info ExchangeInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return ExchangeInfo{}, err
+	}
+	return info, nil
+}
+
+// Symbols returns the metadata of every symbol in the given market, cached in memory.
+func (c *Client) Symbols(market string) (common.Symbols, error) {
+	return c.symbols.Load(market, c.Markets(), func() (common.Symbols, error) {
+		info, err := c.GetExchangeInfo(Market(market))
+		if err != nil {
+			return nil, err
+		}
+
+		symbols := make(common.Symbols, len(info.Symbols))
+		for _, s := range info.Symbols {
+			symbols[s.Symbol] = s.ToSymbolInfo()
+		}
+		return symbols, nil
+	})
+}
+
+// ToSymbolInfo converts the exchangeInfo symbol to the exchange-agnostic SymbolInfo.
+func (s SymbolInfo) ToSymbolInfo() common.SymbolInfo {
+	status := s.Status
+	if status == "" {
+		status = s.ContractStatus
+	}
+	info := common.SymbolInfo{
+		Symbol:       s.Symbol,
+		BaseAsset:    s.BaseAsset,
+		QuoteAsset:   s.QuoteAsset,
+		Status:       status,
+		Trading:      status == "TRADING",
+		ContractSize: s.ContractSize,
+	}
+	for _, f := range s.Filters {
+		switch f.FilterType {
+		case "PRICE_FILTER":
+			info.TickSize = common.ParseFloat(f.TickSize)
+		case "LOT_SIZE":
+			info.LotSize = common.ParseFloat(f.StepSize)
+		}
+	}
+	return info
+}
+
+// symbolsOrEmpty returns the cached metadata of the market. Metadata is best effort:
+// when it cannot be loaded the callers fall back to matching symbol suffixes.
+func (c *Client) symbolsOrEmpty(market Market) common.Symbols {
+	symbols, err := c.Symbols(string(market))
+	if err != nil {
+		return common.Symbols{}
+	}
+	return symbols
+}
@@ -0,0 +1,143 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// tradesLimit is the most trades returned per request by the trades and aggTrades endpoints.
+const tradesLimit = 1000
+
+// tradeResponse is a row of the trades endpoint. COIN-M futures report qty in contracts
+// and the base quantity as baseQty instead of quoteQty.
+type tradeResponse struct {
+	ID           int64  `json:"id"`
+	Price        string `json:"price"`
+	Qty          string `json:"qty"`
+	QuoteQty     string `json:"quoteQty"`
+	BaseQty      string `json:"baseQty"`
+	Time         int64  `json:"time"`
+	IsBuyerMaker bool   `json:"isBuyerMaker"`
+}
+
+// aggTradeResponse is a row of the aggTrades endpoint, the fills of one taker order at
+// one price. IsBestMatch is decoded so that its "M" key, matched case-insensitively,
+// does not overwrite the "m" buyer-maker flag.
+type aggTradeResponse struct {
+	ID           int64  `json:"a"`
+	Price        string `json:"p"`
+	Qty          string `json:"q"`
+	Time         int64  `json:"T"`
+	IsBuyerMaker bool   `json:"m"`
+	IsBestMatch  bool   `json:"M"`
+}
+
+// GetTrades returns up to the query's limit of the most recent trades of a trading pair
+// of a market, oldest first, capped at 1000. With Aggregate, the trades are aggregated
+// by taker order and price; COIN-M aggregated trades only carry contract quantities, so
+// they are not supported in the inverse market.
+func (c *Client) GetTrades(market Market, pairSymbol string, query common.TradeQuery) ([]common.Trade, error) {
+	if !IsValidMarket(market) {
+		return nil, fmt.Errorf("invalid market type: %s", market)
+	}
+	if query.Aggregate && market == Inverse {
+		return nil, fmt.Errorf("%w: %s", common.ErrUnsupportedAggregate, market)
+	}
+	if err := c.symbolsOrEmpty(market).Validate(pairSymbol); err != nil {
+		return nil, err
+	}
+	if query.Limit <= 0 {
+		query.Limit = common.DefaultTradeLimit
+	}
+
+	values := url.Values{}
+	values.Set("symbol", pairSymbol)
+	values.Set("limit", strconv.Itoa(min(query.Limit, tradesLimit)))
+
+	if query.Aggregate {
+		var rows []aggTradeResponse
+		if err := c.getTrades(fmt.Sprintf("%s/aggTrades?%s", c.baseURLs[market], values.Encode()), &rows); err != nil {
+			return nil, err
+		}
+		trades := make([]common.Trade, 0, len(rows))
+		for _, row := range rows {
+			price, quantity := common.ParseFloat(row.Price), common.ParseFloat(row.Qty)
+			trades = append(trades, common.Trade{
+				ID:            strconv.FormatInt(row.ID, 10),
+				Price:         price,
+				Quantity:      quantity,
+				QuoteQuantity: price * quantity,
+				Side:          takerSide(row.IsBuyerMaker),
+				Time:          time.UnixMilli(row.Time).UTC(),
+			})
+		}
+		return trades, nil
+	}
+
+	var rows []tradeResponse
+	if err := c.getTrades(fmt.Sprintf("%s/trades?%s", c.baseURLs[market], values.Encode()), &rows); err != nil {
+		return nil, err
+	}
+	trades := make([]common.Trade, 0, len(rows))
+	for _, row := range rows {
+		trades = append(trades, row.toTrade())
+	}
+	return trades, nil
+}
+
+// getTrades requests a trades endpoint and decodes the rows into out.
+func (c *Client) getTrades(endpoint string, out interface{}) error {
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-MBX-APIKEY", c.apiKey)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP error: %s", resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// toTrade converts the trade to the exchange-agnostic Trade, taking the base quantity of
+// COIN-M trades from baseQty.
+func (t tradeResponse) toTrade() common.Trade {
+	price, quantity := common.ParseFloat(t.Price), common.ParseFloat(t.Qty)
+	if t.BaseQty != "" {
+		quantity = common.ParseFloat(t.BaseQty)
+	}
+	quote := price * quantity
+	if t.QuoteQty != "" {
+		quote = common.ParseFloat(t.QuoteQty)
+	}
+	return common.Trade{
+		ID:            strconv.FormatInt(t.ID, 10),
+		Price:         price,
+		Quantity:      quantity,
+		QuoteQuantity: quote,
+		Side:          takerSide(t.IsBuyerMaker),
+		Time:          time.UnixMilli(t.Time).UTC(),
+	}
+}
+
+// takerSide returns the taker side of a trade from its buyer-maker flag.
+func takerSide(isBuyerMaker bool) string {
+	if isBuyerMaker {
+		return common.SideSell
+	}
+	return common.SideBuy
+}
@@ -0,0 +1,77 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// windowSymbolsLimit is the most symbols the rolling window ticker accepts per request.
+const windowSymbolsLimit = 100
+
+// SupportsWindow reports whether the rankings of the market accept the window. Only spot
+// has a rolling window ticker.
+func (c *Client) SupportsWindow(market string, window common.Window) bool {
+	return Market(market) == Spot && window != "" && common.IsValidWindow(window)
+}
+
+// GetWindowTickerData returns rolling window price statistics for the given trading pairs
+// of a market, requested in batches of windowSymbolsLimit pairs. The rows have the fields
+// of the 24-hour ticker except for the best bid and ask and the previous close.
+func (c *Client) GetWindowTickerData(market Market, window common.Window, pairSymbols []string) ([]TickerData, error) {
+	if !c.SupportsWindow(string(market), window) {
+		return nil, fmt.Errorf("%w: %s on %s", common.ErrUnsupportedWindow, window, market)
+	}
+
+	var tickers []TickerData
+	for start := 0; start < len(pairSymbols); start += windowSymbolsLimit {
+		batch := pairSymbols[start:min(start+windowSymbolsLimit, len(pairSymbols))]
+		encoded, err := json.Marshal(batch)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint := fmt.Sprintf("%s/ticker?windowSize=%s&symbols=%s", c.baseURLs[market], window, url.QueryEscape(string(encoded)))
+		data, err := c.getTickers(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		tickers = append(tickers, data...)
+	}
+	return tickers, nil
+}
+
+// rankWindow ranks the candidate pairs of the query by their rolling window statistics
+// and returns the native window tickers of the ranked pairs.
+func (c *Client) rankWindow(market Market, ranking common.Ranking, query common.GainersQuery) ([]TickerData, error) {
+	data, ranked, err := c.windowTickers(market, ranking, query)
+	if err != nil {
+		return nil, err
+	}
+	return common.Pick(data, func(t TickerData) string { return t.Symbol }, ranked), nil
+}
+
+// windowTickers returns the native window tickers of the candidate pairs of the query,
+// see common.WindowCandidates, and the tickers ranked over the window. The candidates are
+// capped to common.MaxWindowCandidates, i.e. at most two batched window requests.
+func (c *Client) windowTickers(market Market, ranking common.Ranking, query common.GainersQuery) ([]TickerData, []common.Ticker, error) {
+	daily, err := c.Get24HourTickerDataInMarket(market)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	candidates := common.WindowCandidates(c.toTickers(market, daily), query)
+	symbols := make([]string, 0, len(candidates))
+	for _, t := range candidates {
+		symbols = append(symbols, t.Symbol)
+	}
+
+	data, err := c.GetWindowTickerData(market, query.Window, symbols)
+	if err != nil {
+		return nil, nil, err
+	}
+	windowed := common.Windowed(candidates, toTickers(market, data))
+	return data, common.RankWindow(windowed, ranking, query), nil
+}
@@ -3,12 +3,16 @@ package bybit
 import (
 	"fmt"
 	"net/http"
-	"sort"
-	"strings"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
 )
 
 const baseURL = "https://api.bybit.com"
 
+// symbolsTTL is how long instruments-info metadata is cached.
+const symbolsTTL = time.Hour
+
 type Market string
 
 const (
@@ -18,16 +22,16 @@ const (
 	Inverse Market = "inverse"
 )
 
-type PairListResponse struct {
-	Pairs         []string `json:"pairs"`
-	RefreshPeriod int      `json:"refresh_period"`
-}
+// PairListResponse is the Freqtrade-style pair list returned by GetTickersGainerForPairs.
+type PairListResponse = common.PairListResponse
 
 // Client is a struct representing the Client API client.
 type Client struct {
 	apiKey    string
 	apiSecret string
+	baseURL   string
 	client    *http.Client
+	symbols   *common.SymbolCache
 }
 
 // NewClient creates a new instance of the Client API client.
@@ -35,18 +39,20 @@ func NewClient(apiKey, apiSecret string) *Client {
 	return &Client{
 		apiKey:    apiKey,
 		apiSecret: apiSecret,
+		baseURL:   baseURL,
 		client:    &http.Client{},
+		symbols:   common.NewSymbolCache(symbolsTTL),
 	}
 }
 
-// Get24HourTickerData gets tickers from Bybit API for a given market.
+// Get24HourTickerData gets tickers from Bybit API for a given market. The option market
+// lists the options of DefaultBaseCoin; see GetOptionTickers for other base coins.
 func (c *Client) Get24HourTickerData(market Market) (*[]TickerData, error) {
 	if !IsValidMarket(market) {
 		return nil, fmt.Errorf("invalid market type: %s", market)
 	}
 
-	url := fmt.Sprintf("%s/v5/market/tickers?category=%s", baseURL, market)
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequest("GET", c.tickersURL(market), nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating request failed: %v", err)
 	}
@@ -66,10 +72,10 @@ func (c *Client) Get24HourTickerDataSymbol(market Market, symbol string) (*[]Tic
 		return nil, fmt.Errorf("invalid market type: %s", market)
 	}
 
-	if len(symbol) < 3 {
-		return nil, fmt.Errorf("invalid symbol")
+	if err := c.symbolsOrEmpty(market).Validate(symbol); err != nil {
+		return nil, err
 	}
-	url := fmt.Sprintf("%s/v5/market/tickers?category=%s&symbol=%s", baseURL, market, symbol)
+	url := fmt.Sprintf("%s/v5/market/tickers?category=%s&symbol=%s", c.baseURL, market, symbol)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating request failed: %v", err)
@@ -84,118 +90,86 @@ func (c *Client) Get24HourTickerDataSymbol(market Market, symbol string) (*[]Tic
 	return parseResponse(res)
 }
 
-// Get24HourGainersTickerData returns all trading pairs with a positive price change percent
-// of more than +2% over the last 24 hours, sorted by performance (descending order).
-func (c *Client) Get24HourGainersTickerData(market Market, limit int, endingFilter string) ([]TickerData, error) {
-	resp, err := c.Get24HourTickerData(market)
+// GetTickersBatch returns the tickers of several trading pairs of a market from one bulk
+// request, in request order, and an error for every pair the market does not list.
+func (c *Client) GetTickersBatch(market Market, symbols []string) ([]TickerData, []common.SymbolError, error) {
+	data, err := c.Get24HourTickerData(market)
 	if err != nil {
-		return nil, err
-	}
-
-	// Filter profitable pairs.
-	gainerPairs := filterProfitablePairs(*resp)
-
-	// Apply the ending filter.
-	filteredPairs := filterPairsByEnding(gainerPairs, endingFilter)
-
-	// Sort the pairs by performance.
-	sortPairsByPerformance(filteredPairs)
-
-	// Apply the limit if specified
-	if limit > 0 && limit < len(filteredPairs) {
-		filteredPairs = filteredPairs[:limit]
+		return nil, nil, err
 	}
+	tickers, errs := common.PickSymbols(*data, func(t TickerData) string { return t.Symbol }, symbols)
+	return tickers, errs, nil
+}
 
-	return filteredPairs, nil
+// Get24HourGainersTickerData returns all trading pairs with a positive price change percent
+// over the last 24 hours, sorted by performance (descending order).
+func (c *Client) Get24HourGainersTickerData(market Market, limit int, endingFilter string) ([]TickerData, error) {
+	return c.rank(market, common.RankingGainers, common.GainersQuery{Limit: limit, EndingFilter: endingFilter})
 }
 
 // GetTickersGainerForPairs returns formatted trading pair symbols as strings.
 func (c *Client) GetTickersGainerForPairs(market Market, limit int, endingFilter, excludeFilter string) (PairListResponse, error) {
-	resp, err := c.Get24HourTickerData(market)
-	if err != nil {
-		return PairListResponse{}, err
-	}
-
-	// Filter profitable pairs.
-	gainerPairs := filterProfitablePairs(*resp)
-
-	// Apply the ending filter.
-	filteredPairs := filterPairsByEnding(gainerPairs, endingFilter)
+	query := common.GainersQuery{Limit: limit, EndingFilter: endingFilter, ExcludeFilter: excludeFilter}
+	return c.rankPairs(market, common.RankingGainers, query)
+}
 
-	// Apply the exclude filter.
-	finalPairs := make([]TickerData, 0)
-	for _, pair := range filteredPairs {
-		if !strings.Contains(pair.Symbol, excludeFilter) {
-			finalPairs = append(finalPairs, pair)
-		}
+// rank ranks the normalized tickers of the market and returns the matching native tickers.
+func (c *Client) rank(market Market, ranking common.Ranking, query common.GainersQuery) ([]TickerData, error) {
+	data, tickers, err := c.normalizedTickers(market)
+	if err != nil {
+		return nil, err
 	}
 
-	// Sort the pairs by performance.
-	sortPairsByPerformance(finalPairs)
-
-	// Extract the symbols and apply the limit.
-	tradingPairSymbols := extractTradingPairSymbols(finalPairs, limit)
+	ranked := common.Rank(tickers, ranking, query)
+	return common.Pick(data, func(t TickerData) string { return t.Symbol }, ranked), nil
+}
 
-	// Format the pairs.
-	formattedPairs := formatPairs(tradingPairSymbols, endingFilter)
-	response := PairListResponse{
-		Pairs:         formattedPairs,
-		RefreshPeriod: 43200, //12h
+// rankPairs ranks the normalized tickers of the market and formats them as "BASE/QUOTE" pairs.
+func (c *Client) rankPairs(market Market, ranking common.Ranking, query common.GainersQuery) (PairListResponse, error) {
+	_, tickers, err := c.normalizedTickers(market)
+	if err != nil {
+		return PairListResponse{}, err
 	}
-	return response, nil
+	return common.PairList(common.Rank(tickers, ranking, query)), nil
 }
 
-// formatPairs takes a slice of symbols and appends a "/" between the base currency and the endingFilter.
-func formatPairs(symbols []string, endingFilter string) []string {
-	var formattedPairs []string
-	for _, symbol := range symbols {
-		// Trim the endingFilter from the symbol and add it back with a "/" for formatting.
-		base := strings.TrimSuffix(symbol, endingFilter)
-		formattedPair := base + "/" + endingFilter
-		formattedPairs = append(formattedPairs, formattedPair)
+// tickersURL returns the tickers endpoint of every pair of the market. Bybit requires a
+// base coin for the option market, so option tickers are requested for DefaultBaseCoin.
+func (c *Client) tickersURL(market Market) string {
+	if market == Option {
+		return fmt.Sprintf("%s/v5/market/tickers?category=%s&baseCoin=%s", c.baseURL, market, DefaultBaseCoin)
 	}
-	return formattedPairs
+	return fmt.Sprintf("%s/v5/market/tickers?category=%s", c.baseURL, market)
 }
 
-// FilterProfitablePairs filters out pairs that have a positive price change percent.
-func filterProfitablePairs(data []TickerData) []TickerData {
-	var profitablePairs []TickerData
-
-	for _, pair := range data {
-		if pair.Price24hPcntFloat > 0 {
-			profitablePairs = append(profitablePairs, pair)
-		}
+// normalizedTickers returns the native tickers of the market together with their
+// normalized, metadata enriched counterparts.
+func (c *Client) normalizedTickers(market Market) ([]TickerData, []common.Ticker, error) {
+	if !IsValidMarket(market) {
+		return nil, nil, fmt.Errorf("invalid market type: %s", market)
 	}
 
-	return profitablePairs
-}
-
-// FilterPairsByEnding filters pairs by ending if endingFilter is provided.
-func filterPairsByEnding(data []TickerData, endingFilter string) []TickerData {
-	var filteredPairs []TickerData
+	req, err := http.NewRequest("GET", c.tickersURL(market), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating request failed: %v", err)
+	}
 
-	for _, pair := range data {
-		if strings.HasSuffix(pair.Symbol, endingFilter) {
-			filteredPairs = append(filteredPairs, pair)
-		}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("executing request failed: %v", err)
 	}
+	defer res.Body.Close()
 
-	return filteredPairs
-}
+	bybitResponse, err := decodeResponse(res)
+	if err != nil {
+		return nil, nil, err
+	}
 
-// SortPairsByPerformance sorts the pairs based on their price change percent in descending order.
-func sortPairsByPerformance(data []TickerData) {
-	sort.Slice(data, func(i, j int) bool {
-		return data[i].Price24hPcntFloat > data[j].Price24hPcntFloat
-	})
-}
-func extractTradingPairSymbols(data []TickerData, limit int) []string {
-	var symbols []string
-	for i, pair := range data {
-		if limit > 0 && i >= limit {
-			break
-		}
-		symbols = append(symbols, pair.Symbol)
-	}
-	return symbols
+	at := time.UnixMilli(bybitResponse.Time).UTC()
+	tickers := make([]common.Ticker, 0, len(bybitResponse.Result.List))
+	for _, t := range bybitResponse.Result.List {
+		tickers = append(tickers, t.ToTicker(market, at))
+	}
+	c.symbolsOrEmpty(market).Enrich(tickers)
+	return bybitResponse.Result.List, tickers, nil
 }
@@ -0,0 +1,460 @@
+package bybit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+func newTestClient(t *testing.T) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var jsonResponse string
+		query := r.URL.Query()
+		switch {
+		case r.URL.Path == "/v5/market/tickers" && query.Get("category") == "linear":
+			jsonResponse = `{"retCode":0,"retMsg":"OK","result":{"category":"linear","list":[
+				{"symbol":"BTCUSDT","lastPrice":"102","prevPrice24h":"100","price24hPcnt":"0.02","markPrice":"102.1","indexPrice":"102.05","fundingRate":"0.0001","nextFundingTime":"1700006400000","openInterest":"500","openInterestValue":"51000","turnover24h":"1000000","volume24h":"9800"},
+				{"symbol":"ETHUSDT","lastPrice":"105","prevPrice24h":"100","price24hPcnt":"0.05","markPrice":"105","indexPrice":"104.9","fundingRate":"-0.0003","nextFundingTime":"1700006400000","openInterest":"100","openInterestValue":"10500","turnover24h":"500000","volume24h":"4800"},
+				{"symbol":"SOLUSDT","lastPrice":"110","prevPrice24h":"100","price24hPcnt":"0.10","markPrice":"110","indexPrice":"110","fundingRate":"0.0005","nextFundingTime":"1700006400000","openInterest":"10","openInterestValue":"1100","turnover24h":"9000","volume24h":"85"},
+				{"symbol":"XRPUSDT","lastPrice":"90","prevPrice24h":"100","price24hPcnt":"-0.10","fundingRate":"0.0002","nextFundingTime":"1700006400000","openInterest":"1000","openInterestValue":"90000"}
+			]},"time":1700000000000}`
+		case r.URL.Path == "/v5/market/tickers" && query.Get("category") == "option" && query.Get("symbol") != "":
+			jsonResponse = `{"retCode":0,"retMsg":"OK","result":{"category":"option","list":[]},"time":1700000000000}`
+		case r.URL.Path == "/v5/market/tickers" && query.Get("category") == "option":
+			if query.Get("baseCoin") == "" {
+				jsonResponse = `{"retCode":10001,"retMsg":"baseCoin is required","result":{},"time":1700000000000}`
+				break
+			}
+			if query.Get("baseCoin") != "ETH" {
+				t.Errorf("Expected baseCoin ETH, but got %s", query.Get("baseCoin"))
+			}
+			jsonResponse = `{"retCode":0,"retMsg":"OK","result":{"category":"option","list":[
+				{"symbol":"ETH-27DEC24-3000-C","lastPrice":"110","markIv":"0.55","volume24h":"12","turnover24h":"36000","openInterest":"100","delta":"0.52","gamma":"0.0004","vega":"5.1","theta":"-3.2","change24h":"0.1"},
+				{"symbol":"ETH-27DEC24-2500-P","lastPrice":"40","markIv":"0.61","volume24h":"30","turnover24h":"75000","openInterest":"80","delta":"-0.21","change24h":"-0.2"},
+				{"symbol":"ETH-27DEC24-4000-C-USDT","lastPrice":"5","markIv":"0.70","volume24h":"0","turnover24h":"0","openInterest":"10","delta":"0.05","change24h":"0"}
+			]},"time":1700000000000}`
+		case r.URL.Path == "/v5/market/kline" && query.Get("category") == "linear":
+			if query.Get("interval") != "5" || query.Get("limit") != "12" {
+				t.Errorf("Expected 12 five-minute klines, but got %s of interval %s", query.Get("limit"), query.Get("interval"))
+			}
+			rows := `["1700003600000","100","101","99","100","10","1000"],["1700003300000","100","100","100","100","1","100"]`
+			if query.Get("symbol") == "SOLUSDT" {
+				rows = `["1700003600000","104","109","103","108","10","1060"],["1700003300000","100","104","100","104","5","510"]`
+			}
+			jsonResponse = `{"retCode":0,"retMsg":"OK","result":{"category":"linear","symbol":"` + query.Get("symbol") + `","list":[` + rows + `]},"time":1700003700000}`
+		case r.URL.Path == "/v5/market/kline" && query.Get("category") == "inverse":
+			if query.Get("interval") != "D" {
+				t.Errorf("Expected daily klines, but got interval %s", query.Get("interval"))
+			}
+			// The history has five daily klines from 2024-01-01; the latest ones up to end
+			// are returned newest first.
+			end, _ := strconv.ParseInt(query.Get("end"), 10, 64)
+			limit, _ := strconv.Atoi(query.Get("limit"))
+			var rows []string
+			for i := 4; i >= 0 && len(rows) < limit; i-- {
+				openTime := time.Date(2024, 1, 1+i, 0, 0, 0, 0, time.UTC).UnixMilli()
+				if end == 0 || openTime <= end {
+					rows = append(rows, fmt.Sprintf(`["%d","%d","%d","%d","%d","1","10"]`, openTime, 100+i, 110+i, 90+i, 101+i))
+				}
+			}
+			jsonResponse = `{"retCode":0,"retMsg":"OK","result":{"category":"inverse","symbol":"BTCUSD","list":[` + strings.Join(rows, ",") + `]},"time":1700000000000}`
+		case r.URL.Path == "/v5/market/orderbook" && query.Get("category") == "spot":
+			if query.Get("limit") != "200" {
+				t.Errorf("Expected the spot limit to be capped at 200, but got %s", query.Get("limit"))
+			}
+			jsonResponse = `{"retCode":0,"retMsg":"OK","result":{"s":"BTCUSDT","b":[["99.9","10"],["99.5","20"]],"a":[["100.1","10"],["100.5","20"]],"ts":1700000000000,"u":18521288},"time":1700000000001}`
+		case r.URL.Path == "/v5/market/orderbook" && query.Get("category") == "inverse":
+			jsonResponse = `{"retCode":0,"retMsg":"OK","result":{"s":"BTCUSD","b":[["50000","10000"]],"a":[["50100","5010"]],"ts":1700000000000,"u":1},"time":1700000000001}`
+		case r.URL.Path == "/v5/market/recent-trade" && query.Get("category") == "inverse":
+			jsonResponse = `{"retCode":0,"retMsg":"OK","result":{"category":"inverse","list":[
+				{"execId":"b2","symbol":"BTCUSD","price":"50000","size":"1000","side":"Sell","time":"1700000060000","isBlockTrade":false},
+				{"execId":"b1","symbol":"BTCUSD","price":"49900","size":"4990","side":"Buy","time":"1700000000000","isBlockTrade":false}
+			]},"time":1700000060001}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(jsonResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient("", "")
+	client.baseURL = server.URL
+	client.client = server.Client()
+	return client
+}
+
+func TestGetOptionTickers(t *testing.T) {
+	client := newTestClient(t)
+
+	options, err := client.GetOptionTickers("eth")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(options) != 3 {
+		t.Fatalf("Expected 3 options, but got %d", len(options))
+	}
+	if options[0].Delta != "0.52" || options[0].MarkIv != "0.55" {
+		t.Errorf("Expected the Greeks of ETH-27DEC24-3000-C, but got %+v", options[0])
+	}
+}
+
+func TestGet24HourTickerDataOption(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/v5/market/tickers" {
+			_, _ = fmt.Fprint(w, `{"retCode":0,"retMsg":"OK","result":{"category":"option","list":[]},"time":1700000000000}`)
+			return
+		}
+		if query.Get("category") != "option" || query.Get("baseCoin") != DefaultBaseCoin {
+			t.Errorf("Expected option tickers of %s, but got %s", DefaultBaseCoin, r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprint(w, `{"retCode":0,"retMsg":"OK","result":{"category":"option","list":[
+			{"symbol":"BTC-27DEC24-60000-C","lastPrice":"1200","volume24h":"3","turnover24h":"180000"}
+		]},"time":1700000000000}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("", "")
+	client.baseURL = server.URL
+
+	data, err := client.Get24HourTickerData(Option)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(*data) != 1 || (*data)[0].Symbol != "BTC-27DEC24-60000-C" {
+		t.Errorf("Expected BTC-27DEC24-60000-C, but got %+v", *data)
+	}
+	if _, _, err := client.normalizedTickers(Option); err != nil {
+		t.Errorf("Expected no error for normalized option tickers, but got %v", err)
+	}
+}
+
+func TestRankOptions(t *testing.T) {
+	client := newTestClient(t)
+
+	tests := []struct {
+		rankBy string
+		want   []string
+	}{
+		{common.RankByVolume, []string{"ETH-27DEC24-2500-P", "ETH-27DEC24-3000-C"}},
+		{common.RankByOpenInterest, []string{"ETH-27DEC24-3000-C", "ETH-27DEC24-2500-P", "ETH-27DEC24-4000-C-USDT"}},
+		{common.RankByIV, []string{"ETH-27DEC24-4000-C-USDT", "ETH-27DEC24-2500-P", "ETH-27DEC24-3000-C"}},
+		{common.RankByChange, []string{"ETH-27DEC24-3000-C"}},
+	}
+	for _, tt := range tests {
+		options, err := client.RankOptions(common.OptionsQuery{BaseCoin: "ETH", RankBy: tt.rankBy})
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if len(options) != len(tt.want) {
+			t.Fatalf("%s: expected %d options, but got %d", tt.rankBy, len(tt.want), len(options))
+		}
+		for i, symbol := range tt.want {
+			if options[i].Symbol != symbol {
+				t.Errorf("%s: expected %s at position %d, but got %s", tt.rankBy, symbol, i, options[i].Symbol)
+			}
+		}
+	}
+
+	if _, err := client.RankOptions(common.OptionsQuery{BaseCoin: "ETH", RankBy: "gamma"}); err == nil {
+		t.Error("Expected an error for an unknown ranking key")
+	}
+}
+
+func TestRankOptionBaseCoin(t *testing.T) {
+	client := newTestClient(t)
+
+	ranked, err := client.Rank(string(Option), common.RankingLosers, common.GainersQuery{BaseCoin: "ETH"})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	losers := ranked.([]OptionTickerData)
+	if len(losers) != 1 || losers[0].Symbol != "ETH-27DEC24-2500-P" {
+		t.Errorf("Expected the ETH-27DEC24-2500-P loser, but got %+v", losers)
+	}
+
+	pairs, err := client.RankPairs(string(Option), common.RankingGainers, common.GainersQuery{BaseCoin: "ETH", Limit: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(pairs.Pairs) != 1 {
+		t.Errorf("Expected 1 ETH option pair, but got %v", pairs.Pairs)
+	}
+}
+
+func TestNormalizedOptionTickers(t *testing.T) {
+	client := newTestClient(t)
+
+	tickers, err := client.NormalizedOptionTickers("ETH")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	call := tickers[0]
+	if call.BaseAsset != "ETH" || call.QuoteAsset != "USDC" || call.Market != "option" {
+		t.Errorf("Expected an ETH/USDC option, but got %+v", call)
+	}
+	if call.ChangePercent < 9.99 || call.ChangePercent > 10.01 || call.OpenPrice < 99.99 || call.OpenPrice > 100.01 {
+		t.Errorf("Expected a 10%% change from 100, but got %v from %v", call.ChangePercent, call.OpenPrice)
+	}
+	if tickers[2].QuoteAsset != "USDT" {
+		t.Errorf("Expected a USDT settled option, but got %s", tickers[2].QuoteAsset)
+	}
+}
+
+func TestGetOptionTickerUnknownSymbol(t *testing.T) {
+	client := newTestClient(t)
+
+	_, err := client.GetOptionTicker("ETH-1JAN20-1-C")
+	if !errors.Is(err, common.ErrUnknownSymbol) {
+		t.Errorf("Expected ErrUnknownSymbol, but got %v", err)
+	}
+}
+
+func TestGetDerivativeTickers(t *testing.T) {
+	client := newTestClient(t)
+
+	tickers, err := client.Tickers(string(Linear))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	contracts := tickers.([]DerivativeTickerData)
+	if len(contracts) != 4 {
+		t.Fatalf("Expected 4 contracts, but got %d", len(contracts))
+	}
+	if contracts[0].FundingRate != "0.0001" || contracts[0].OpenInterestValue != "51000" || contracts[0].LastPrice != "102" {
+		t.Errorf("Expected the derivative fields of BTCUSDT, but got %+v", contracts[0])
+	}
+
+	normalized, err := client.NormalizedTickers(string(Linear))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	btc := normalized[0]
+	if btc.FundingRate != 0.0001 || btc.MarkPrice != 102.1 || btc.NextFundingTime == nil || btc.NextFundingTime.UnixMilli() != 1700006400000 {
+		t.Errorf("Expected the normalized derivative fields of BTCUSDT, but got %+v", btc)
+	}
+}
+
+func TestGetDerivativeGainers(t *testing.T) {
+	client := newTestClient(t)
+	minFunding := 0.0
+
+	gainers, err := client.GetDerivativeGainers(Linear, common.GainersQuery{
+		SortBy:         common.SortByOpenInterestValue,
+		MinFundingRate: &minFunding,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(gainers) != 2 || gainers[0].Symbol != "BTCUSDT" || gainers[1].Symbol != "SOLUSDT" {
+		t.Errorf("Expected BTCUSDT and SOLUSDT by open interest value, but got %+v", gainers)
+	}
+
+	pairs, err := client.GetDerivativeGainerPairs(Linear, common.GainersQuery{SortBy: common.SortByFundingRate, Limit: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(pairs.Pairs) != 2 || pairs.Pairs[0] != "SOL/USDT" || pairs.Pairs[1] != "BTC/USDT" {
+		t.Errorf("Expected SOL/USDT and BTC/USDT by funding rate, but got %v", pairs.Pairs)
+	}
+}
+
+func TestTickersFor(t *testing.T) {
+	client := newTestClient(t)
+
+	tests := []struct {
+		market   string
+		symbols  []string
+		want     []string
+		wantErrs []string
+	}{
+		{"linear", []string{"solusdt", "BTCUSDT", "FOOUSDT", "BTCUSDT"}, []string{"SOLUSDT", "BTCUSDT"}, []string{"FOOUSDT"}},
+		{"option", []string{"ETH-27DEC24-2500-P", "ETH-27DEC24-9999-C"}, []string{"ETH-27DEC24-2500-P"}, []string{"ETH-27DEC24-9999-C"}},
+	}
+
+	for _, test := range tests {
+		data, errs, err := client.TickersFor(test.market, test.symbols)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+
+		var got []string
+		switch tickers := data.(type) {
+		case []DerivativeTickerData:
+			for _, ticker := range tickers {
+				got = append(got, ticker.Symbol)
+			}
+		case []OptionTickerData:
+			for _, ticker := range tickers {
+				got = append(got, ticker.Symbol)
+			}
+		default:
+			t.Fatalf("Expected %s tickers, but got %T", test.market, data)
+		}
+		if len(got) != len(test.want) {
+			t.Fatalf("Expected %v, but got %v", test.want, got)
+		}
+		for i := range test.want {
+			if got[i] != test.want[i] {
+				t.Errorf("Expected %v, but got %v", test.want, got)
+			}
+		}
+
+		if len(errs) != len(test.wantErrs) {
+			t.Fatalf("Expected errors for %v, but got %v", test.wantErrs, errs)
+		}
+		for i := range test.wantErrs {
+			if errs[i].Symbol != test.wantErrs[i] {
+				t.Errorf("Expected errors for %v, but got %v", test.wantErrs, errs)
+			}
+		}
+	}
+}
+
+func TestRankWindow(t *testing.T) {
+	client := newTestClient(t)
+
+	query := common.GainersQuery{Window: common.Window1h}
+	data, err := client.Rank(string(Linear), common.RankingGainers, query)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	tickers, ok := data.([]common.Ticker)
+	if !ok {
+		t.Fatalf("Expected normalized tickers, but got %T", data)
+	}
+	if len(tickers) != 1 || tickers[0].Symbol != "SOLUSDT" {
+		t.Fatalf("Expected SOLUSDT, the only gainer over the window, but got %+v", tickers)
+	}
+	if tickers[0].ChangePercent != 8 || tickers[0].QuoteVolume != 1570 || tickers[0].FundingRate != 0.0005 {
+		t.Errorf("Expected the window change and volume with the daily funding rate, but got %+v", tickers[0])
+	}
+
+	if client.SupportsWindow(string(Option), common.Window1h) {
+		t.Errorf("Expected no window support in the option market")
+	}
+}
+
+func TestGetKlines(t *testing.T) {
+	client := newTestClient(t)
+
+	start := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	candles, err := client.GetKlines(Inverse, "BTCUSD", common.CandleQuery{Interval: common.Interval1d, Start: start, Limit: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(candles) != 2 || !candles[0].OpenTime.Equal(start) || candles[1].Open != 102 {
+		t.Fatalf("Expected the klines of January 2 and 3 oldest first, but got %+v", candles)
+	}
+	if candles[0].High != 111 || candles[0].Low != 91 || candles[0].Close != 102 || candles[0].Volume != 10 || candles[0].QuoteVolume != 1 {
+		t.Errorf("Expected the fields of the January 2 kline, but got %+v", candles[0])
+	}
+
+	if _, err := client.GetKlines(Option, "BTC-27DEC24-60000-C", common.CandleQuery{Interval: common.Interval1d}); err == nil {
+		t.Errorf("Expected an error for the option market")
+	}
+}
+
+func TestRankWindowInverse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var jsonResponse string
+		query := r.URL.Query()
+		switch {
+		case r.URL.Path == "/v5/market/tickers":
+			jsonResponse = `{"retCode":0,"retMsg":"OK","result":{"category":"inverse","list":[
+				{"symbol":"BTCUSD","lastPrice":"102","prevPrice24h":"100","price24hPcnt":"0.02","volume24h":"5000000","turnover24h":"50"},
+				{"symbol":"ETHUSD","lastPrice":"105","prevPrice24h":"100","price24hPcnt":"0.05","volume24h":"1000000","turnover24h":"10"}
+			]},"time":1700000000000}`
+		case r.URL.Path == "/v5/market/kline" && query.Get("symbol") == "BTCUSD":
+			// Inverse klines report the volume in USD contracts and the turnover in BTC.
+			jsonResponse = `{"retCode":0,"retMsg":"OK","result":{"category":"inverse","symbol":"BTCUSD","list":[
+				["1700003600000","100","104","100","104","20000","200"],["1700003300000","100","100","100","100","10000","100"]
+			]},"time":1700003700000}`
+		case r.URL.Path == "/v5/market/kline":
+			jsonResponse = `{"retCode":10006,"retMsg":"Too many visits","result":{},"time":1700003700000}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(jsonResponse))
+	}))
+	defer server.Close()
+
+	client := NewClient("", "")
+	client.baseURL = server.URL
+	client.client = server.Client()
+
+	data, err := client.Rank(string(Inverse), common.RankingGainers, common.GainersQuery{Window: common.Window1h})
+	var partial *common.PartialError
+	if !errors.As(err, &partial) || partial.Errors["ETHUSD"] == "" {
+		t.Fatalf("Expected a partial error for ETHUSD, but got %v", err)
+	}
+	tickers := data.([]common.Ticker)
+	if len(tickers) != 1 || tickers[0].Symbol != "BTCUSD" {
+		t.Fatalf("Expected BTCUSD only, but got %+v", tickers)
+	}
+	if tickers[0].QuoteVolume != 30000 || tickers[0].Volume != 300 {
+		t.Errorf("Expected the USD contract volume as quote volume, but got %v quote and %v base", tickers[0].QuoteVolume, tickers[0].Volume)
+	}
+}
+
+func TestGetOrderBook(t *testing.T) {
+	client := newTestClient(t)
+
+	book, err := client.GetOrderBook(Spot, "BTCUSDT", 500)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(book.Bids) != 2 || book.Bids[0] != (common.Level{Price: 99.9, Quantity: 10}) || book.Asks[1] != (common.Level{Price: 100.5, Quantity: 20}) {
+		t.Fatalf("Expected the levels of the response, but got %+v", book)
+	}
+	if !book.Time.Equal(time.UnixMilli(1700000000000)) {
+		t.Errorf("Expected the snapshot time, but got %v", book.Time)
+	}
+	if mid, _ := book.Mid(); mid != 100 {
+		t.Errorf("Expected mid 100, but got %v", mid)
+	}
+
+	inverse, err := client.GetOrderBook(Inverse, "BTCUSD", 500)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if inverse.Bids[0].Quantity != 0.2 || inverse.Asks[0].Quantity != 0.1 {
+		t.Errorf("Expected the USD contract sizes in BTC, but got %+v", inverse)
+	}
+}
+
+func TestGetTrades(t *testing.T) {
+	client := newTestClient(t)
+
+	trades, err := client.GetTrades(Inverse, "BTCUSD", common.TradeQuery{Limit: 100})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(trades) != 2 || trades[0].ID != "b1" || trades[1].ID != "b2" {
+		t.Fatalf("Expected the trades oldest first, but got %+v", trades)
+	}
+	if trades[0].Side != common.SideBuy || trades[0].QuoteQuantity != 4990 || trades[0].Quantity != 0.1 {
+		t.Errorf("Expected a taker buy of 4990 USD contracts, but got %+v", trades[0])
+	}
+	if trades[1].Side != common.SideSell || !trades[1].Time.Equal(time.UnixMilli(1700000060000)) {
+		t.Errorf("Expected a taker sell at the trade time, but got %+v", trades[1])
+	}
+
+	if _, err := client.GetTrades(Inverse, "BTCUSD", common.TradeQuery{Aggregate: true}); !errors.Is(err, common.ErrUnsupportedAggregate) {
+		t.Errorf("Expected ErrUnsupportedAggregate, but got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package bybit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// depthLimits are the most levels per side of the order books of every market.
+var depthLimits = map[Market]int{
+	Spot:    200,
+	Linear:  500,
+	Inverse: 500,
+	Option:  25,
+}
+
+// GetOrderBook returns the order book of a trading pair of a market with up to limit
+// levels per side, capped at the maximum of the market.
+func (c *Client) GetOrderBook(market Market, symbol string, limit int) (common.OrderBook, error) {
+	if !IsValidMarket(market) {
+		return common.OrderBook{}, fmt.Errorf("invalid market type: %s", market)
+	}
+	if market != Option {
+		if err := c.symbolsOrEmpty(market).Validate(symbol); err != nil {
+			return common.OrderBook{}, err
+		}
+	}
+
+	query := url.Values{}
+	query.Set("category", string(market))
+	query.Set("symbol", symbol)
+	query.Set("limit", strconv.Itoa(max(1, min(limit, depthLimits[market]))))
+	endpoint := fmt.Sprintf("%s/v5/market/orderbook?%s", c.baseURL, query.Encode())
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return common.OrderBook{}, fmt.Errorf("creating request failed: %v", err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return common.OrderBook{}, fmt.Errorf("executing request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return common.OrderBook{}, fmt.Errorf("received non-OK response status: %s", res.Status)
+	}
+
+	var response OrderBookResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return common.OrderBook{}, fmt.Errorf("decoding response failed: %v", err)
+	}
+	if response.RetCode != 0 {
+		return common.OrderBook{}, fmt.Errorf("bybit error %d: %s", response.RetCode, response.RetMsg)
+	}
+
+	return common.OrderBook{
+		Exchange: Name,
+		Market:   string(market),
+		Symbol:   symbol,
+		Bids:     levels(market, response.Result.Bids),
+		Asks:     levels(market, response.Result.Asks),
+		Time:     time.UnixMilli(response.Result.Ts).UTC(),
+	}, nil
+}
+
+// levels converts [price, size] pairs of the market to order book levels. Inverse
+// contracts are sized in USD, so their size is converted to the base quantity.
+func levels(market Market, pairs [][2]string) []common.Level {
+	out := make([]common.Level, 0, len(pairs))
+	for _, pair := range pairs {
+		price, quantity := common.ParseFloat(pair[0]), common.ParseFloat(pair[1])
+		if market == Inverse && price > 0 {
+			quantity /= price
+		} else if market == Inverse {
+			quantity = 0
+		}
+		out = append(out, common.Level{Price: price, Quantity: quantity})
+	}
+	return out
+}
@@ -0,0 +1,171 @@
+package bybit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// IsDerivativeMarket reports whether the market lists linear or inverse contracts,
+// whose tickers carry funding and open interest fields.
+func IsDerivativeMarket(m Market) bool {
+	return m == Linear || m == Inverse
+}
+
+// GetDerivativeTickers returns the tickers, including mark price, funding and open
+// interest, of every contract in the linear or inverse market.
+func (c *Client) GetDerivativeTickers(market Market) ([]DerivativeTickerData, error) {
+	response, err := c.derivativeTickers(market, "")
+	if err != nil {
+		return nil, err
+	}
+	return response.Result.List, nil
+}
+
+// GetDerivativeTicker returns the ticker of a single contract in the linear or inverse market.
+func (c *Client) GetDerivativeTicker(market Market, symbol string) (*DerivativeTickerData, error) {
+	if err := c.symbolsOrEmpty(market).Validate(symbol); err != nil {
+		return nil, err
+	}
+
+	response, err := c.derivativeTickers(market, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Result.List) == 0 {
+		return nil, fmt.Errorf("%w: %s", common.ErrUnknownSymbol, symbol)
+	}
+	return &response.Result.List[0], nil
+}
+
+// GetDerivativeTickersBatch returns the tickers of several contracts of the linear or
+// inverse market from one bulk request, in request order, and an error for every
+// contract the market does not list.
+func (c *Client) GetDerivativeTickersBatch(market Market, symbols []string) ([]DerivativeTickerData, []common.SymbolError, error) {
+	response, err := c.derivativeTickers(market, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	tickers, errs := common.PickSymbols(response.Result.List, func(t DerivativeTickerData) string { return t.Symbol }, symbols)
+	return tickers, errs, nil
+}
+
+// GetDerivativeGainers returns the gaining contracts of the linear or inverse market
+// ranked and filtered by the query, including its funding and open interest options.
+func (c *Client) GetDerivativeGainers(market Market, query common.GainersQuery) ([]DerivativeTickerData, error) {
+	return c.rankDerivatives(market, common.RankingGainers, query)
+}
+
+// GetDerivativeGainerPairs returns the gaining contracts of the linear or inverse market
+// as a pair list.
+func (c *Client) GetDerivativeGainerPairs(market Market, query common.GainersQuery) (PairListResponse, error) {
+	return c.rankDerivativePairs(market, common.RankingGainers, query)
+}
+
+// rankDerivatives ranks the normalized contracts of the market and returns the matching
+// native tickers.
+func (c *Client) rankDerivatives(market Market, ranking common.Ranking, query common.GainersQuery) ([]DerivativeTickerData, error) {
+	data, tickers, err := c.normalizedDerivativeTickers(market)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := common.Rank(tickers, ranking, query)
+	return common.Pick(data, func(t DerivativeTickerData) string { return t.Symbol }, ranked), nil
+}
+
+// rankDerivativePairs ranks the normalized contracts of the market and formats them as
+// "BASE/QUOTE" pairs.
+func (c *Client) rankDerivativePairs(market Market, ranking common.Ranking, query common.GainersQuery) (PairListResponse, error) {
+	_, tickers, err := c.normalizedDerivativeTickers(market)
+	if err != nil {
+		return PairListResponse{}, err
+	}
+	return common.PairList(common.Rank(tickers, ranking, query)), nil
+}
+
+// normalizedDerivativeTickers returns the native tickers of the market together with
+// their normalized, metadata enriched counterparts.
+func (c *Client) normalizedDerivativeTickers(market Market) ([]DerivativeTickerData, []common.Ticker, error) {
+	response, err := c.derivativeTickers(market, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	at := time.UnixMilli(response.Time).UTC()
+	tickers := make([]common.Ticker, 0, len(response.Result.List))
+	for _, t := range response.Result.List {
+		tickers = append(tickers, t.ToTicker(market, at))
+	}
+	c.symbolsOrEmpty(market).Enrich(tickers)
+	return response.Result.List, tickers, nil
+}
+
+func (c *Client) derivativeTickers(market Market, symbol string) (*DerivativeResponse, error) {
+	if !IsDerivativeMarket(market) {
+		return nil, fmt.Errorf("invalid market type: %s", market)
+	}
+
+	query := url.Values{}
+	query.Set("category", string(market))
+	if symbol != "" {
+		query.Set("symbol", symbol)
+	}
+	endpoint := fmt.Sprintf("%s/v5/market/tickers?%s", c.baseURL, query.Encode())
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request failed: %v", err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("executing request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received non-OK response status: %s", res.Status)
+	}
+
+	var response DerivativeResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decoding response failed: %v", err)
+	}
+	if response.RetCode != 0 {
+		return nil, fmt.Errorf("bybit error %d: %s", response.RetCode, response.RetMsg)
+	}
+
+	for i := range response.Result.List {
+		response.Result.List[i].Price24hPcntFloat = common.ParseFloat(response.Result.List[i].Price24hPcnt)
+	}
+	return &response, nil
+}
+
+// ToTicker converts the contract ticker to the exchange-agnostic Ticker, including its
+// derivative fields. Inverse contracts are sized in the quote currency (USD), so their
+// volume24h and openInterest are quote amounts and turnover24h and openInterestValue
+// are base amounts.
+func (t DerivativeTickerData) ToTicker(market Market, at time.Time) common.Ticker {
+	ticker := t.TickerData.ToTicker(market, at)
+	ticker.MarkPrice = common.ParseFloat(t.MarkPrice)
+	ticker.IndexPrice = common.ParseFloat(t.IndexPrice)
+	ticker.FundingRate = common.ParseFloat(t.FundingRate)
+	ticker.OpenInterest = common.ParseFloat(t.OpenInterest)
+	ticker.OpenInterestValue = common.ParseFloat(t.OpenInterestValue)
+	ticker.Basis = common.ParseFloat(t.Basis)
+	if market == Inverse {
+		ticker.Volume = common.ParseFloat(t.Turnover24h)
+		ticker.QuoteVolume = common.ParseFloat(t.Volume24h)
+		ticker.OpenInterest, ticker.OpenInterestValue = ticker.OpenInterestValue, ticker.OpenInterest
+	}
+	if ms, err := strconv.ParseInt(t.NextFundingTime, 10, 64); err == nil && ms > 0 {
+		next := time.UnixMilli(ms).UTC()
+		ticker.NextFundingTime = &next
+	}
+	return ticker
+}
@@ -0,0 +1,175 @@
+package bybit
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// Name is the key the Bybit client is registered under.
+const Name = "bybit"
+
+// Name returns the exchange name.
+func (c *Client) Name() string {
+	return Name
+}
+
+// Markets returns the market types supported by the client, spot first.
+func (c *Client) Markets() []string {
+	return []string{string(Spot), string(Linear), string(Option), string(Inverse)}
+}
+
+// Tickers returns 24-hour ticker data for all trading pairs in the given market.
+// Linear and inverse tickers include funding and open interest, and option tickers are
+// those of DefaultBaseCoin.
+func (c *Client) Tickers(market string) (interface{}, error) {
+	if Market(market) == Option {
+		return c.GetOptionTickers(DefaultBaseCoin)
+	}
+	if IsDerivativeMarket(Market(market)) {
+		return c.GetDerivativeTickers(Market(market))
+	}
+	return c.Get24HourTickerData(Market(market))
+}
+
+// Ticker returns 24-hour ticker data for a single trading pair in the given market.
+func (c *Client) Ticker(market, pair string) (interface{}, error) {
+	if Market(market) == Option {
+		return c.GetOptionTicker(pair)
+	}
+	if IsDerivativeMarket(Market(market)) {
+		return c.GetDerivativeTicker(Market(market), pair)
+	}
+	return c.Get24HourTickerDataSymbol(Market(market), pair)
+}
+
+// TickersFor returns 24-hour ticker data for the given trading pairs of the market from
+// the filtered bulk ticker response, and an error for every pair the market does not list.
+func (c *Client) TickersFor(market string, symbols []string) (interface{}, []common.SymbolError, error) {
+	if Market(market) == Option {
+		return c.GetOptionTickersBatch(symbols)
+	}
+	if IsDerivativeMarket(Market(market)) {
+		return c.GetDerivativeTickersBatch(Market(market), symbols)
+	}
+	return c.GetTickersBatch(Market(market), symbols)
+}
+
+// Candles returns the candles of a trading pair of the given market selected by the query.
+func (c *Client) Candles(market, pair string, query common.CandleQuery) ([]common.Candle, error) {
+	return c.GetKlines(Market(market), pair, query)
+}
+
+// OrderBook returns the order book of a trading pair of the given market with up to
+// limit levels per side.
+func (c *Client) OrderBook(market, pair string, limit int) (common.OrderBook, error) {
+	return c.GetOrderBook(Market(market), pair, limit)
+}
+
+// Trades returns the most recent trades of a trading pair of the given market, oldest first.
+func (c *Client) Trades(market, pair string, query common.TradeQuery) ([]common.Trade, error) {
+	return c.GetTrades(Market(market), pair, query)
+}
+
+// Rank returns the trading pairs of the given market kept and ordered by the ranking.
+// Linear and inverse rankings honour the sort key and derivative filters of the query.
+// Option rankings cover the query's base coin, DefaultBaseCoin when empty, and option
+// gainers are ranked by DefaultOptionRank instead. Rankings over a query window are
+// returned as normalized tickers.
+func (c *Client) Rank(market string, ranking common.Ranking, query common.GainersQuery) (interface{}, error) {
+	m := Market(market)
+	switch {
+	case query.Window != "":
+		return c.rankWindow(m, ranking, query)
+	case m == Option && ranking == common.RankingGainers:
+		return c.RankOptions(common.OptionsQuery{BaseCoin: query.BaseCoin, Limit: query.Limit})
+	case m == Option:
+		return c.rankOptionTickers(query.BaseCoin, ranking, query)
+	case IsDerivativeMarket(m):
+		return c.rankDerivatives(m, ranking, query)
+	default:
+		return c.rank(m, ranking, query)
+	}
+}
+
+// RankPairs returns the ranked trading pairs of the given market as a pair list.
+// Linear and inverse rankings honour the sort key and derivative filters of the query.
+// Option rankings cover the query's base coin, DefaultBaseCoin when empty, and option
+// gainers are ranked by DefaultOptionRank instead.
+func (c *Client) RankPairs(market string, ranking common.Ranking, query common.GainersQuery) (common.PairListResponse, error) {
+	m := Market(market)
+	switch {
+	case query.Window != "":
+		ranked, err := c.rankWindow(m, ranking, query)
+		var partial *common.PartialError
+		if err != nil && !errors.As(err, &partial) {
+			return common.PairListResponse{}, err
+		}
+		return common.PairList(ranked), err
+	case m == Option && ranking == common.RankingGainers:
+		return c.GetOptionPairs(common.OptionsQuery{BaseCoin: query.BaseCoin, Limit: query.Limit, ExcludeFilter: query.ExcludeFilter})
+	case m == Option:
+		options, err := c.rankOptionTickers(query.BaseCoin, ranking, query)
+		if err != nil {
+			return common.PairListResponse{}, err
+		}
+		return optionPairList(options), nil
+	case IsDerivativeMarket(m):
+		return c.rankDerivativePairs(m, ranking, query)
+	default:
+		return c.rankPairs(m, ranking, query)
+	}
+}
+
+// NormalizedTickers returns 24-hour ticker data for all trading pairs in the given market
+// converted to the exchange-agnostic Ticker.
+func (c *Client) NormalizedTickers(market string) ([]common.Ticker, error) {
+	m := Market(market)
+	if m == Option {
+		return c.NormalizedOptionTickers(DefaultBaseCoin)
+	}
+	if IsDerivativeMarket(m) {
+		_, tickers, err := c.normalizedDerivativeTickers(m)
+		return tickers, err
+	}
+
+	_, tickers, err := c.normalizedTickers(m)
+	return tickers, err
+}
+
+// OptionMarket returns the market type that lists options.
+func (c *Client) OptionMarket() string {
+	return string(Option)
+}
+
+// OptionTickers returns the tickers, including Greeks, of every option on the base coin.
+func (c *Client) OptionTickers(baseCoin string) (interface{}, error) {
+	return c.GetOptionTickers(baseCoin)
+}
+
+// NormalizedOptionTickers returns the option tickers of the base coin converted to the
+// exchange-agnostic Ticker.
+func (c *Client) NormalizedOptionTickers(baseCoin string) ([]common.Ticker, error) {
+	response, err := c.optionTickers(baseCoin)
+	if err != nil {
+		return nil, err
+	}
+
+	at := time.UnixMilli(response.Time).UTC()
+	tickers := make([]common.Ticker, 0, len(response.Result.List))
+	for _, t := range response.Result.List {
+		tickers = append(tickers, t.ToTicker(at))
+	}
+	return tickers, nil
+}
+
+// Options returns the options of the query's base coin ranked by the query.
+func (c *Client) Options(query common.OptionsQuery) (interface{}, error) {
+	return c.RankOptions(query)
+}
+
+// OptionPairs returns the options of the query's base coin ranked by the query as a pair list.
+func (c *Client) OptionPairs(query common.OptionsQuery) (common.PairListResponse, error) {
+	return c.GetOptionPairs(query)
+}
@@ -5,9 +5,21 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
 )
 
 func parseResponse(res *http.Response) (*[]TickerData, error) {
+	bybitResponse, err := decodeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	return &bybitResponse.Result.List, nil
+}
+
+// decodeResponse decodes a tickers response and fills in the parsed percent change of every ticker.
+func decodeResponse(res *http.Response) (*Response, error) {
 	if res.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("received non-OK response status: %s", res.Status)
 	}
@@ -24,7 +36,36 @@ func parseResponse(res *http.Response) (*[]TickerData, error) {
 		}
 		data = append(data, i)
 	}
-	return &data, nil
+	bybitResponse.Result.List = data
+	return &bybitResponse, nil
+}
+
+// ToTicker converts the Bybit ticker of the given market to the exchange-agnostic Ticker.
+// Bybit reports price24hPcnt as a fraction, so it is scaled to percent, and turnover24h
+// is the quote volume. Tickers carry no timestamps, so the 24-hour window ends at the
+// given response time.
+func (t TickerData) ToTicker(market Market, at time.Time) common.Ticker {
+	base, quote := common.SplitSymbol(t.Symbol)
+	return common.Ticker{
+		Exchange:      Name,
+		Market:        string(market),
+		Symbol:        t.Symbol,
+		BaseAsset:     base,
+		QuoteAsset:    quote,
+		LastPrice:     common.ParseFloat(t.LastPrice),
+		OpenPrice:     common.ParseFloat(t.PrevPrice24h),
+		HighPrice:     common.ParseFloat(t.HighPrice24h),
+		LowPrice:      common.ParseFloat(t.LowPrice24h),
+		BidPrice:      common.ParseFloat(t.Bid1Price),
+		BidQuantity:   common.ParseFloat(t.Bid1Size),
+		AskPrice:      common.ParseFloat(t.Ask1Price),
+		AskQuantity:   common.ParseFloat(t.Ask1Size),
+		Volume:        common.ParseFloat(t.Volume24h),
+		QuoteVolume:   common.ParseFloat(t.Turnover24h),
+		ChangePercent: common.ParseFloat(t.Price24hPcnt) * 100,
+		OpenTime:      at.Add(-24 * time.Hour),
+		CloseTime:     at,
+	}
 }
 
 // IsValidMarket checks if the provided market is valid.
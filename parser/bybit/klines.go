@@ -0,0 +1,113 @@
+package bybit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// klinesLimit is the most klines Bybit returns per request.
+const klinesLimit = 1000
+
+// klineIntervals maps the candle intervals to the Bybit kline intervals.
+var klineIntervals = map[common.Interval]string{
+	common.Interval1m:  "1",
+	common.Interval3m:  "3",
+	common.Interval5m:  "5",
+	common.Interval15m: "15",
+	common.Interval30m: "30",
+	common.Interval1h:  "60",
+	common.Interval2h:  "120",
+	common.Interval4h:  "240",
+	common.Interval6h:  "360",
+	common.Interval12h: "720",
+	common.Interval1d:  "D",
+	common.Interval1w:  "W",
+	common.Interval1M:  "M",
+}
+
+// GetKlines returns the candles of a trading pair of the spot, linear or inverse market
+// selected by the query, oldest first. Queries for more than klinesLimit candles are
+// paged through.
+func (c *Client) GetKlines(market Market, symbol string, query common.CandleQuery) ([]common.Candle, error) {
+	if !IsValidMarket(market) || market == Option {
+		return nil, fmt.Errorf("invalid market type: %s", market)
+	}
+	if _, ok := klineIntervals[query.Interval]; !ok {
+		return nil, fmt.Errorf("invalid interval: %s", query.Interval)
+	}
+	if err := c.symbolsOrEmpty(market).Validate(symbol); err != nil {
+		return nil, err
+	}
+
+	return common.FetchCandles(query, klinesLimit, func(end time.Time, limit int) ([]common.Candle, error) {
+		return c.klines(market, symbol, query.Interval, end, limit)
+	})
+}
+
+// klines requests at most limit klines of a trading pair opening at or before end, or
+// the latest ones when end is zero, and returns them oldest first. Inverse contracts are
+// sized in the quote currency (USD), so their volume is a quote amount and their turnover
+// a base amount; see DerivativeTickerData.ToTicker.
+func (c *Client) klines(market Market, symbol string, interval common.Interval, end time.Time, limit int) ([]common.Candle, error) {
+	query := url.Values{}
+	query.Set("category", string(market))
+	query.Set("symbol", symbol)
+	query.Set("interval", klineIntervals[interval])
+	query.Set("limit", strconv.Itoa(limit))
+	if !end.IsZero() {
+		query.Set("end", strconv.FormatInt(end.UnixMilli(), 10))
+	}
+	endpoint := fmt.Sprintf("%s/v5/market/kline?%s", c.baseURL, query.Encode())
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request failed: %v", err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("executing request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received non-OK response status: %s", res.Status)
+	}
+
+	var response KlineResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decoding response failed: %v", err)
+	}
+	if response.RetCode != 0 {
+		return nil, fmt.Errorf("bybit error %d: %s", response.RetCode, response.RetMsg)
+	}
+
+	candles := make([]common.Candle, 0, len(response.Result.List))
+	for _, row := range response.Result.List {
+		if len(row) < 7 {
+			continue
+		}
+		start, _ := strconv.ParseInt(row[0], 10, 64)
+		candles = append(candles, common.Candle{
+			OpenTime:    time.UnixMilli(start).UTC(),
+			Open:        common.ParseFloat(row[1]),
+			High:        common.ParseFloat(row[2]),
+			Low:         common.ParseFloat(row[3]),
+			Close:       common.ParseFloat(row[4]),
+			Volume:      common.ParseFloat(row[5]),
+			QuoteVolume: common.ParseFloat(row[6]),
+		})
+		if market == Inverse {
+			last := &candles[len(candles)-1]
+			last.Volume, last.QuoteVolume = last.QuoteVolume, last.Volume
+		}
+	}
+	slices.Reverse(candles)
+	return candles, nil
+}
@@ -0,0 +1,255 @@
+package bybit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// DefaultBaseCoin is the underlying queried for options when none is given, since
+// Bybit only lists option tickers per base coin.
+const DefaultBaseCoin = "BTC"
+
+// defaultOptionQuote is the settlement coin of option symbols without a quote suffix.
+const defaultOptionQuote = "USDC"
+
+// GetOptionTickers returns the tickers of every option contract on the given base coin.
+func (c *Client) GetOptionTickers(baseCoin string) ([]OptionTickerData, error) {
+	response, err := c.optionTickers(baseCoin)
+	if err != nil {
+		return nil, err
+	}
+	return response.Result.List, nil
+}
+
+// GetOptionTicker returns the ticker of a single option contract, e.g. BTC-27DEC24-60000-C.
+func (c *Client) GetOptionTicker(symbol string) (*OptionTickerData, error) {
+	query := url.Values{}
+	query.Set("category", string(Option))
+	query.Set("symbol", symbol)
+	response, err := c.getOptionTickers(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Result.List) == 0 {
+		return nil, fmt.Errorf("%w: %s", common.ErrUnknownSymbol, symbol)
+	}
+	return &response.Result.List[0], nil
+}
+
+// GetOptionTickersBatch returns the tickers of several option contracts, e.g.
+// BTC-27DEC24-60000-C, in request order, and an error for every contract that is not
+// listed. Bybit lists option tickers per base coin, so one request is made per base coin.
+func (c *Client) GetOptionTickersBatch(symbols []string) ([]OptionTickerData, []common.SymbolError, error) {
+	symbols = common.UniqueSymbols(symbols)
+	var options []OptionTickerData
+	fetched := make(map[string]bool)
+	for _, symbol := range symbols {
+		baseCoin, _ := splitOptionSymbol(symbol)
+		if fetched[baseCoin] {
+			continue
+		}
+		fetched[baseCoin] = true
+
+		response, err := c.optionTickers(baseCoin)
+		if err != nil {
+			return nil, nil, err
+		}
+		options = append(options, response.Result.List...)
+	}
+
+	tickers, errs := common.PickSymbols(options, func(t OptionTickerData) string { return t.Symbol }, symbols)
+	return tickers, errs, nil
+}
+
+// RankOptions returns the option contracts of the query's base coin ranked by the query's
+// ranking key in descending order. Contracts whose ranking value is not positive, e.g.
+// untraded ones when ranking by volume, are dropped. Bybit reports no 24-hour IV change,
+// so RankByIV ranks by the current mark IV.
+func (c *Client) RankOptions(query common.OptionsQuery) ([]OptionTickerData, error) {
+	if query.RankBy == "" {
+		query.RankBy = common.DefaultOptionRank
+	}
+	if !common.IsValidOptionRank(query.RankBy) {
+		return nil, fmt.Errorf("invalid option rank: %s", query.RankBy)
+	}
+
+	options, err := c.GetOptionTickers(query.BaseCoin)
+	if err != nil {
+		return nil, err
+	}
+	return rankOptions(options, query), nil
+}
+
+// GetOptionPairs returns the ranked option contracts as a pair list. Options are listed by
+// their raw symbol, since every contract of an underlying shares the same base and quote.
+func (c *Client) GetOptionPairs(query common.OptionsQuery) (PairListResponse, error) {
+	options, err := c.RankOptions(query)
+	if err != nil {
+		return PairListResponse{}, err
+	}
+	return optionPairList(options), nil
+}
+
+// rankOptionTickers ranks the normalized options of the base coin with the generic ranking,
+// e.g. the options that lost most, and returns the matching native tickers.
+func (c *Client) rankOptionTickers(baseCoin string, ranking common.Ranking, query common.GainersQuery) ([]OptionTickerData, error) {
+	response, err := c.optionTickers(baseCoin)
+	if err != nil {
+		return nil, err
+	}
+
+	at := time.UnixMilli(response.Time).UTC()
+	tickers := make([]common.Ticker, 0, len(response.Result.List))
+	for _, t := range response.Result.List {
+		tickers = append(tickers, t.ToTicker(at))
+	}
+	// Every option of an underlying shares its quote asset, so the ending filter is not applied.
+	query.EndingFilter = ""
+	ranked := common.Rank(tickers, ranking, query)
+	return common.Pick(response.Result.List, func(t OptionTickerData) string { return t.Symbol }, ranked), nil
+}
+
+// optionPairList lists the options by their raw symbol.
+func optionPairList(options []OptionTickerData) PairListResponse {
+	pairs := make([]string, 0, len(options))
+	for _, o := range options {
+		pairs = append(pairs, o.Symbol)
+	}
+	return PairListResponse{
+		Pairs:         pairs,
+		RefreshPeriod: common.DefaultRefreshPeriod,
+	}
+}
+
+// optionTickers requests the option tickers of a base coin, defaulting to DefaultBaseCoin.
+func (c *Client) optionTickers(baseCoin string) (*OptionResponse, error) {
+	if baseCoin == "" {
+		baseCoin = DefaultBaseCoin
+	}
+
+	query := url.Values{}
+	query.Set("category", string(Option))
+	query.Set("baseCoin", strings.ToUpper(baseCoin))
+	return c.getOptionTickers(query)
+}
+
+func (c *Client) getOptionTickers(query url.Values) (*OptionResponse, error) {
+	endpoint := fmt.Sprintf("%s/v5/market/tickers?%s", c.baseURL, query.Encode())
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request failed: %v", err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("executing request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received non-OK response status: %s", res.Status)
+	}
+
+	var response OptionResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decoding response failed: %v", err)
+	}
+	if response.RetCode != 0 {
+		return nil, fmt.Errorf("bybit error %d: %s", response.RetCode, response.RetMsg)
+	}
+	return &response, nil
+}
+
+// rankOptions filters and sorts the options by the ranking key of the query and applies
+// the comma-separated exclude list, matching symbol substrings, and the limit.
+func rankOptions(options []OptionTickerData, query common.OptionsQuery) []OptionTickerData {
+	exclude, _ := common.NewMatcher(common.MatchContains, query.ExcludeFilter, common.MatchContains)
+	ranked := make([]OptionTickerData, 0)
+	for _, o := range options {
+		if o.rankValue(query.RankBy) <= 0 {
+			continue
+		}
+		if exclude.Match(common.Ticker{Symbol: o.Symbol}) {
+			continue
+		}
+		ranked = append(ranked, o)
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].rankValue(query.RankBy) > ranked[j].rankValue(query.RankBy)
+	})
+
+	if query.Limit > 0 && query.Limit < len(ranked) {
+		ranked = ranked[:query.Limit]
+	}
+	return ranked
+}
+
+// rankValue returns the value the option is ranked by for the given ranking key.
+func (t OptionTickerData) rankValue(rankBy string) float64 {
+	switch rankBy {
+	case common.RankByVolume:
+		return common.ParseFloat(t.Volume24h)
+	case common.RankByTurnover:
+		return common.ParseFloat(t.Turnover24h)
+	case common.RankByOpenInterest:
+		return common.ParseFloat(t.OpenInterest)
+	case common.RankByIV:
+		return common.ParseFloat(t.MarkIv)
+	case common.RankByChange:
+		return common.ParseFloat(t.Change24h)
+	default:
+		return 0
+	}
+}
+
+// ToTicker converts the option ticker to the exchange-agnostic Ticker. The open price is
+// derived from change24h, which Bybit reports as a fraction, and the 24-hour window ends
+// at the given response time.
+func (t OptionTickerData) ToTicker(at time.Time) common.Ticker {
+	base, quote := splitOptionSymbol(t.Symbol)
+	last := common.ParseFloat(t.LastPrice)
+	change := common.ParseFloat(t.Change24h)
+
+	var open float64
+	if change != -1 {
+		open = last / (1 + change)
+	}
+	return common.Ticker{
+		Exchange:      Name,
+		Market:        string(Option),
+		Symbol:        t.Symbol,
+		BaseAsset:     base,
+		QuoteAsset:    quote,
+		LastPrice:     last,
+		OpenPrice:     open,
+		HighPrice:     common.ParseFloat(t.HighPrice24h),
+		LowPrice:      common.ParseFloat(t.LowPrice24h),
+		BidPrice:      common.ParseFloat(t.Bid1Price),
+		BidQuantity:   common.ParseFloat(t.Bid1Size),
+		AskPrice:      common.ParseFloat(t.Ask1Price),
+		AskQuantity:   common.ParseFloat(t.Ask1Size),
+		Volume:        common.ParseFloat(t.Volume24h),
+		QuoteVolume:   common.ParseFloat(t.Turnover24h),
+		ChangePercent: change * 100,
+		OpenTime:      at.Add(-24 * time.Hour),
+		CloseTime:     at,
+	}
+}
+
+// splitOptionSymbol returns the base and settlement coin of an option symbol such as
+// BTC-27DEC24-60000-C (USDC settled) or BTC-27DEC24-60000-C-USDT.
+func splitOptionSymbol(symbol string) (base, quote string) {
+	parts := strings.Split(symbol, "-")
+	if len(parts) == 5 {
+		return parts[0], parts[4]
+	}
+	return parts[0], defaultOptionQuote
+}
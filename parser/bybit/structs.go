@@ -29,3 +29,170 @@ type TickerData struct {
 	UsdIndexPrice     string  `json:"usdIndexPrice"`
 	Price24hPcntFloat float64 `json:"price_24_h_pcnt_float"`
 }
+
+// DerivativeResponse is the tickers response of the linear and inverse categories.
+type DerivativeResponse struct {
+	RetCode int              `json:"retCode"`
+	RetMsg  string           `json:"retMsg"`
+	Result  DerivativeResult `json:"result"`
+	Time    int64            `json:"time"`
+}
+
+type DerivativeResult struct {
+	Category string                 `json:"category"`
+	List     []DerivativeTickerData `json:"list"`
+}
+
+// DerivativeTickerData is the ticker of a linear or inverse contract. Perpetuals report
+// the funding rate and next funding time, delivery futures the basis and delivery time.
+type DerivativeTickerData struct {
+	TickerData
+	IndexPrice             string `json:"indexPrice"`
+	MarkPrice              string `json:"markPrice"`
+	PrevPrice1h            string `json:"prevPrice1h"`
+	OpenInterest           string `json:"openInterest"`
+	OpenInterestValue      string `json:"openInterestValue"`
+	FundingRate            string `json:"fundingRate"`
+	NextFundingTime        string `json:"nextFundingTime"`
+	PredictedDeliveryPrice string `json:"predictedDeliveryPrice"`
+	BasisRate              string `json:"basisRate"`
+	Basis                  string `json:"basis"`
+	DeliveryFeeRate        string `json:"deliveryFeeRate"`
+	DeliveryTime           string `json:"deliveryTime"`
+}
+
+// OptionResponse is the tickers response of the option category.
+type OptionResponse struct {
+	RetCode int          `json:"retCode"`
+	RetMsg  string       `json:"retMsg"`
+	Result  OptionResult `json:"result"`
+	Time    int64        `json:"time"`
+}
+
+type OptionResult struct {
+	Category string             `json:"category"`
+	List     []OptionTickerData `json:"list"`
+}
+
+// OptionTickerData is the ticker of an option contract, including its implied
+// volatility and Greeks. Bybit reports IVs and change24h as fractions.
+type OptionTickerData struct {
+	Symbol                 string `json:"symbol"`
+	Bid1Price              string `json:"bid1Price"`
+	Bid1Size               string `json:"bid1Size"`
+	Bid1Iv                 string `json:"bid1Iv"`
+	Ask1Price              string `json:"ask1Price"`
+	Ask1Size               string `json:"ask1Size"`
+	Ask1Iv                 string `json:"ask1Iv"`
+	LastPrice              string `json:"lastPrice"`
+	HighPrice24h           string `json:"highPrice24h"`
+	LowPrice24h            string `json:"lowPrice24h"`
+	MarkPrice              string `json:"markPrice"`
+	IndexPrice             string `json:"indexPrice"`
+	MarkIv                 string `json:"markIv"`
+	UnderlyingPrice        string `json:"underlyingPrice"`
+	OpenInterest           string `json:"openInterest"`
+	Turnover24h            string `json:"turnover24h"`
+	Volume24h              string `json:"volume24h"`
+	TotalVolume            string `json:"totalVolume"`
+	TotalTurnover          string `json:"totalTurnover"`
+	Delta                  string `json:"delta"`
+	Gamma                  string `json:"gamma"`
+	Vega                   string `json:"vega"`
+	Theta                  string `json:"theta"`
+	PredictedDeliveryPrice string `json:"predictedDeliveryPrice"`
+	Change24h              string `json:"change24h"`
+}
+
+// KlineResponse is the kline response. Every row of the list is
+// [startTime, open, high, low, close, volume, turnover], newest first.
+type KlineResponse struct {
+	RetCode int         `json:"retCode"`
+	RetMsg  string      `json:"retMsg"`
+	Result  KlineResult `json:"result"`
+	Time    int64       `json:"time"`
+}
+
+type KlineResult struct {
+	Category string     `json:"category"`
+	Symbol   string     `json:"symbol"`
+	List     [][]string `json:"list"`
+}
+
+// OrderBookResponse is the order book response.
+type OrderBookResponse struct {
+	RetCode int             `json:"retCode"`
+	RetMsg  string          `json:"retMsg"`
+	Result  OrderBookResult `json:"result"`
+	Time    int64           `json:"time"`
+}
+
+// OrderBookResult is an order book snapshot; every level is a [price, size] pair, best first.
+type OrderBookResult struct {
+	Symbol string      `json:"s"`
+	Bids   [][2]string `json:"b"`
+	Asks   [][2]string `json:"a"`
+	Ts     int64       `json:"ts"`
+}
+
+// RecentTradeResponse is the recent public trades response.
+type RecentTradeResponse struct {
+	RetCode int               `json:"retCode"`
+	RetMsg  string            `json:"retMsg"`
+	Result  RecentTradeResult `json:"result"`
+	Time    int64             `json:"time"`
+}
+
+// RecentTradeResult lists the recent trades of a symbol, newest first.
+type RecentTradeResult struct {
+	Category string      `json:"category"`
+	List     []TradeData `json:"list"`
+}
+
+// TradeData is a public trade. Side is the taker side, Buy or Sell, and the size of
+// inverse contracts is in USD.
+type TradeData struct {
+	ExecID       string `json:"execId"`
+	Symbol       string `json:"symbol"`
+	Price        string `json:"price"`
+	Size         string `json:"size"`
+	Side         string `json:"side"`
+	Time         string `json:"time"`
+	IsBlockTrade bool   `json:"isBlockTrade"`
+}
+
+type InstrumentsResponse struct {
+	RetCode int               `json:"retCode"`
+	RetMsg  string            `json:"retMsg"`
+	Result  InstrumentsResult `json:"result"`
+	Time    int64             `json:"time"`
+}
+
+type InstrumentsResult struct {
+	Category       string           `json:"category"`
+	List           []InstrumentInfo `json:"list"`
+	NextPageCursor string           `json:"nextPageCursor"`
+}
+
+// InstrumentInfo is the instruments-info metadata of a trading pair.
+type InstrumentInfo struct {
+	Symbol        string        `json:"symbol"`
+	BaseCoin      string        `json:"baseCoin"`
+	QuoteCoin     string        `json:"quoteCoin"`
+	SettleCoin    string        `json:"settleCoin"`
+	Status        string        `json:"status"`
+	PriceFilter   PriceFilter   `json:"priceFilter"`
+	LotSizeFilter LotSizeFilter `json:"lotSizeFilter"`
+}
+
+type PriceFilter struct {
+	TickSize string `json:"tickSize"`
+}
+
+// LotSizeFilter holds the quantity rules of an instrument. Spot reports basePrecision,
+// derivatives report qtyStep.
+type LotSizeFilter struct {
+	BasePrecision string `json:"basePrecision"`
+	QtyStep       string `json:"qtyStep"`
+	MinOrderQty   string `json:"minOrderQty"`
+}
@@ -0,0 +1,114 @@
+package bybit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// instrumentsPageSize is the maximum page size of the instruments-info endpoint.
+const instrumentsPageSize = 1000
+
+// GetInstrumentsInfo returns the metadata of every instrument in the given market,
+// following the response cursor until all pages are read.
+func (c *Client) GetInstrumentsInfo(market Market) ([]InstrumentInfo, error) {
+	if !IsValidMarket(market) {
+		return nil, fmt.Errorf("invalid market type: %s", market)
+	}
+
+	var instruments []InstrumentInfo
+	cursor := ""
+	for {
+		query := url.Values{}
+		query.Set("category", string(market))
+		query.Set("limit", fmt.Sprint(instrumentsPageSize))
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+
+		page, err := c.getInstrumentsPage(query)
+		if err != nil {
+			return nil, err
+		}
+		instruments = append(instruments, page.List...)
+
+		if page.NextPageCursor == "" || page.NextPageCursor == cursor || len(page.List) == 0 {
+			return instruments, nil
+		}
+		cursor = page.NextPageCursor
+	}
+}
+
+func (c *Client) getInstrumentsPage(query url.Values) (InstrumentsResult, error) {
+	endpoint := fmt.Sprintf("%s/v5/market/instruments-info?%s", c.baseURL, query.Encode())
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return InstrumentsResult{}, fmt.Errorf("creating request failed: %v", err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return InstrumentsResult{}, fmt.Errorf("executing request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return InstrumentsResult{}, fmt.Errorf("received non-OK response status: %s", res.Status)
+	}
+
+	var response InstrumentsResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return InstrumentsResult{}, fmt.Errorf("decoding response failed: %v", err)
+	}
+	if response.RetCode != 0 {
+		return InstrumentsResult{}, fmt.Errorf("bybit error %d: %s", response.RetCode, response.RetMsg)
+	}
+	return response.Result, nil
+}
+
+// Symbols returns the metadata of every symbol in the given market, cached in memory.
+func (c *Client) Symbols(market string) (common.Symbols, error) {
+	m := Market(market)
+	return c.symbols.Get(market, func() (common.Symbols, error) {
+		instruments, err := c.GetInstrumentsInfo(m)
+		if err != nil {
+			return nil, err
+		}
+
+		symbols := make(common.Symbols, len(instruments))
+		for _, i := range instruments {
+			symbols[i.Symbol] = i.ToSymbolInfo()
+		}
+		return symbols, nil
+	})
+}
+
+// ToSymbolInfo converts the instrument to the exchange-agnostic SymbolInfo.
+func (i InstrumentInfo) ToSymbolInfo() common.SymbolInfo {
+	lotSize := i.LotSizeFilter.QtyStep
+	if lotSize == "" {
+		lotSize = i.LotSizeFilter.BasePrecision
+	}
+	return common.SymbolInfo{
+		Symbol:     i.Symbol,
+		BaseAsset:  i.BaseCoin,
+		QuoteAsset: i.QuoteCoin,
+		Status:     i.Status,
+		Trading:    i.Status == "Trading",
+		TickSize:   common.ParseFloat(i.PriceFilter.TickSize),
+		LotSize:    common.ParseFloat(lotSize),
+	}
+}
+
+// symbolsOrEmpty returns the cached metadata of the market. Metadata is best effort:
+// when it cannot be loaded the callers fall back to matching symbol suffixes.
+func (c *Client) symbolsOrEmpty(market Market) common.Symbols {
+	symbols, err := c.Symbols(string(market))
+	if err != nil {
+		return common.Symbols{}
+	}
+	return symbols
+}
@@ -0,0 +1,105 @@
+package bybit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// tradeLimits are the most recent trades returned for every market.
+var tradeLimits = map[Market]int{
+	Spot:    60,
+	Linear:  1000,
+	Inverse: 1000,
+	Option:  1000,
+}
+
+// GetTrades returns up to the query's limit of the most recent trades of a trading pair
+// of a market, oldest first, capped at the maximum of the market. Bybit serves no
+// aggregated trades.
+func (c *Client) GetTrades(market Market, symbol string, query common.TradeQuery) ([]common.Trade, error) {
+	if !IsValidMarket(market) {
+		return nil, fmt.Errorf("invalid market type: %s", market)
+	}
+	if query.Aggregate {
+		return nil, fmt.Errorf("%w: %s", common.ErrUnsupportedAggregate, market)
+	}
+	if market != Option {
+		if err := c.symbolsOrEmpty(market).Validate(symbol); err != nil {
+			return nil, err
+		}
+	}
+	if query.Limit <= 0 {
+		query.Limit = common.DefaultTradeLimit
+	}
+
+	values := url.Values{}
+	values.Set("category", string(market))
+	values.Set("symbol", symbol)
+	values.Set("limit", strconv.Itoa(min(query.Limit, tradeLimits[market])))
+	endpoint := fmt.Sprintf("%s/v5/market/recent-trade?%s", c.baseURL, values.Encode())
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request failed: %v", err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("executing request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received non-OK response status: %s", res.Status)
+	}
+
+	var response RecentTradeResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decoding response failed: %v", err)
+	}
+	if response.RetCode != 0 {
+		return nil, fmt.Errorf("bybit error %d: %s", response.RetCode, response.RetMsg)
+	}
+
+	trades := make([]common.Trade, 0, len(response.Result.List))
+	for _, t := range response.Result.List {
+		trades = append(trades, t.ToTrade(market))
+	}
+	// Bybit lists the newest trade first.
+	slices.Reverse(trades)
+	return trades, nil
+}
+
+// ToTrade converts the trade to the exchange-agnostic Trade. Inverse contracts are sized
+// in USD, so their size is the quote quantity there.
+func (t TradeData) ToTrade(market Market) common.Trade {
+	price, size := common.ParseFloat(t.Price), common.ParseFloat(t.Size)
+	quantity, quote := size, price*size
+	if market == Inverse {
+		quote = size
+		quantity = 0
+		if price > 0 {
+			quantity = size / price
+		}
+	}
+
+	side := common.SideBuy
+	if t.Side == "Sell" {
+		side = common.SideSell
+	}
+	millis, _ := strconv.ParseInt(t.Time, 10, 64)
+	return common.Trade{
+		ID:            t.ExecID,
+		Price:         price,
+		Quantity:      quantity,
+		QuoteQuantity: quote,
+		Side:          side,
+		Time:          time.UnixMilli(millis).UTC(),
+	}
+}
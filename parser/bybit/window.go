@@ -0,0 +1,111 @@
+package bybit
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// windowConcurrency is the most kline requests in flight while ranking a window.
+const windowConcurrency = 10
+
+// windowKlines maps a rolling window to the kline interval and the number of
+// klines that cover it.
+var windowKlines = map[common.Window]struct {
+	interval common.Interval
+	count    int
+}{
+	common.Window1h: {common.Interval5m, 12},
+	common.Window4h: {common.Interval15m, 16},
+	common.Window1d: {common.Interval1h, 24},
+	common.Window7d: {common.Interval4h, 42},
+}
+
+// SupportsWindow reports whether the rankings of the market accept the window. Windows
+// are derived from the klines of the spot, linear and inverse markets.
+func (c *Client) SupportsWindow(market string, window common.Window) bool {
+	_, ok := windowKlines[window]
+	return ok && Market(market) != Option && IsValidMarket(Market(market))
+}
+
+// rankWindow ranks the candidate pairs of the query by the statistics of their klines
+// over the query window; see common.WindowCandidates, which caps the candidates since
+// every candidate costs a kline request. Bybit has no native window ticker, so the ranked tickers are returned
+// normalized. Pairs whose klines cannot be fetched are left out and reported in a
+// *common.PartialError.
+func (c *Client) rankWindow(market Market, ranking common.Ranking, query common.GainersQuery) ([]common.Ticker, error) {
+	if !c.SupportsWindow(string(market), query.Window) {
+		return nil, fmt.Errorf("%w: %s on %s", common.ErrUnsupportedWindow, query.Window, market)
+	}
+
+	daily, err := c.NormalizedTickers(string(market))
+	if err != nil {
+		return nil, err
+	}
+	candidates := common.WindowCandidates(daily, query)
+	window, err := c.windowTickers(market, query.Window, candidates)
+	var partial *common.PartialError
+	if err != nil && !errors.As(err, &partial) {
+		return nil, err
+	}
+	return common.RankWindow(common.Windowed(candidates, window), ranking, query), err
+}
+
+// windowTickers derives the window tickers of the given tickers from their klines, with
+// at most windowConcurrency requests in flight. Pairs without klines are left out, and
+// pairs whose klines cannot be fetched are reported in a *common.PartialError, or in a
+// plain error when every pair failed.
+func (c *Client) windowTickers(market Market, window common.Window, tickers []common.Ticker) ([]common.Ticker, error) {
+	klines := windowKlines[window]
+	type result struct {
+		ticker common.Ticker
+		ok     bool
+		err    error
+	}
+
+	results := make([]result, len(tickers))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < windowConcurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				candles, err := c.klines(market, tickers[i].Symbol, klines.interval, time.Time{}, klines.count)
+				if err != nil {
+					results[i] = result{err: err}
+					continue
+				}
+				ticker, ok := common.CandleTicker(tickers[i].Symbol, candles)
+				results[i] = result{ticker: ticker, ok: ok}
+			}
+		}()
+	}
+	for i := range tickers {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	windowed := make([]common.Ticker, 0, len(tickers))
+	failures := make(map[string]string)
+	for i, r := range results {
+		if r.err != nil {
+			failures[tickers[i].Symbol] = r.err.Error()
+			continue
+		}
+		if r.ok {
+			windowed = append(windowed, r.ticker)
+		}
+	}
+	if len(failures) > 0 && len(failures) == len(tickers) {
+		return nil, fmt.Errorf("fetching klines failed for every pair: %v", &common.PartialError{Errors: failures})
+	}
+	if len(failures) > 0 {
+		return windowed, &common.PartialError{Errors: failures}
+	}
+	return windowed, nil
+}
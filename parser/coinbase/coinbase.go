@@ -0,0 +1,336 @@
+package coinbase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+const baseURL = "https://api.exchange.coinbase.com"
+
+// symbolsTTL is how long products metadata is cached.
+const symbolsTTL = time.Hour
+
+// defaultConcurrency bounds the stats requests in flight.
+const defaultConcurrency = 8
+
+// requestInterval paces the requests of a client to 8 per second, under the public rate
+// limit of Coinbase Exchange of about 10 requests per second.
+const requestInterval = time.Second / 8
+
+// statsTTL is how long the 24-hour statistics of a product are reused, so that repeated
+// rankings do not request the stats of every product again.
+const statsTTL = time.Minute
+
+// PairListResponse is the Freqtrade-style pair list returned by GetTickersGainerForPairs.
+type PairListResponse = common.PairListResponse
+
+// errNotFound is returned by get when Coinbase answers 404.
+var errNotFound = errors.New("not found")
+
+// Client is a struct representing the Coinbase Exchange API client.
+type Client struct {
+	apiKey      string
+	apiSecret   string
+	baseURL     string
+	client      *http.Client
+	symbols     *common.SymbolCache
+	concurrency int
+	pacer       *pacer
+
+	mu    sync.Mutex
+	stats map[string]cachedStats
+}
+
+// cachedStats is the 24-hour statistics of a product and when they were fetched.
+type cachedStats struct {
+	stats     Stats
+	fetchedAt time.Time
+}
+
+// pacer spaces requests at least interval apart.
+type pacer struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// wait blocks until the next request slot.
+func (p *pacer) wait() {
+	p.mu.Lock()
+	now := time.Now()
+	if p.next.Before(now) {
+		p.next = now
+	}
+	delay := p.next.Sub(now)
+	p.next = p.next.Add(p.interval)
+	p.mu.Unlock()
+
+	time.Sleep(delay)
+}
+
+// NewClient creates a new instance of the Coinbase Exchange API client.
+func NewClient(apiKey, apiSecret string) *Client {
+	return &Client{
+		apiKey:      apiKey,
+		apiSecret:   apiSecret,
+		baseURL:     baseURL,
+		client:      &http.Client{},
+		symbols:     common.NewSymbolCache(symbolsTTL),
+		concurrency: defaultConcurrency,
+		pacer:       &pacer{interval: requestInterval},
+		stats:       make(map[string]cachedStats),
+	}
+}
+
+// GetProducts returns the metadata of every product.
+func (c *Client) GetProducts() ([]Product, error) {
+	var products []Product
+	if err := c.get("/products", &products); err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// GetProductStats returns the 24-hour statistics of a product, e.g. BTC-USD. Statistics
+// fetched within statsTTL are reused.
+func (c *Client) GetProductStats(productID string) (Stats, error) {
+	c.mu.Lock()
+	cached, ok := c.stats[productID]
+	c.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < statsTTL {
+		return cached.stats, nil
+	}
+
+	var stats Stats
+	if err := c.get("/products/"+url.PathEscape(productID)+"/stats", &stats); err != nil {
+		if errors.Is(err, errNotFound) {
+			return Stats{}, fmt.Errorf("%w: %s", common.ErrUnknownSymbol, productID)
+		}
+		return Stats{}, err
+	}
+
+	c.mu.Lock()
+	c.stats[productID] = cachedStats{stats: stats, fetchedAt: time.Now()}
+	c.mu.Unlock()
+	return stats, nil
+}
+
+// Get24HourTickerData returns 24-hour ticker data for every tradable product. Coinbase has
+// no bulk ticker, so the stats of every product are fetched concurrently. When some products
+// fail, the others are returned together with a *PartialError.
+func (c *Client) Get24HourTickerData() ([]TickerData, error) {
+	products, err := c.tradableProducts("")
+	if err != nil {
+		return nil, err
+	}
+	return c.fetchTickers(products)
+}
+
+// GetTickerForPair returns 24-hour ticker data for a single product, e.g. BTC-USD.
+func (c *Client) GetTickerForPair(productID string) (TickerData, error) {
+	productID = c.resolveProduct(productID)
+	stats, err := c.GetProductStats(productID)
+	if err != nil {
+		return TickerData{}, err
+	}
+	return stats.toTickerData(productID), nil
+}
+
+// Get24HourGainersTickerData returns all products with a positive price change over
+// the last 24 hours, sorted by performance (descending order).
+func (c *Client) Get24HourGainersTickerData(limit int, endingFilter string) ([]TickerData, error) {
+	return c.rank(common.RankingGainers, common.GainersQuery{Limit: limit, EndingFilter: endingFilter})
+}
+
+// GetTickersGainerForPairs returns the top gainers formatted as "BASE/QUOTE" pairs.
+func (c *Client) GetTickersGainerForPairs(limit int, endingFilter, excludeFilter string) (PairListResponse, error) {
+	query := common.GainersQuery{Limit: limit, EndingFilter: endingFilter, ExcludeFilter: excludeFilter}
+	return c.rankPairs(common.RankingGainers, query)
+}
+
+// rank ranks the normalized tickers and returns the matching native tickers. Only the
+// products quoted in the ending filter are fetched. Products whose stats cannot be fetched
+// are left out of the ranking and reported in a *PartialError.
+func (c *Client) rank(ranking common.Ranking, query common.GainersQuery) ([]TickerData, error) {
+	products, err := c.tradableProducts(query.EndingFilter)
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.fetchTickers(products)
+	if fatal(err) {
+		return nil, err
+	}
+
+	ranked := common.Rank(c.toTickers(data), ranking, query)
+	return common.Pick(data, func(t TickerData) string { return t.Symbol }, ranked), err
+}
+
+// rankPairs ranks the normalized tickers and formats them as "BASE/QUOTE" pairs, reporting
+// the products whose stats cannot be fetched in a *PartialError.
+func (c *Client) rankPairs(ranking common.Ranking, query common.GainersQuery) (PairListResponse, error) {
+	tickers, err := c.normalizedTickers(query.EndingFilter)
+	if fatal(err) {
+		return PairListResponse{}, err
+	}
+	return common.PairList(common.Rank(tickers, ranking, query)), err
+}
+
+// normalizedTickers returns the tickers of the tradable products quoted in quote, or of every
+// tradable product when quote is empty, converted to the exchange-agnostic Ticker. Products
+// whose stats cannot be fetched are left out and reported in a *PartialError.
+func (c *Client) normalizedTickers(quote string) ([]common.Ticker, error) {
+	products, err := c.tradableProducts(quote)
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.fetchTickers(products)
+	if fatal(err) {
+		return nil, err
+	}
+	return c.toTickers(data), err
+}
+
+// toTickers converts native tickers and enriches them with the products metadata.
+func (c *Client) toTickers(data []TickerData) []common.Ticker {
+	tickers := make([]common.Ticker, 0, len(data))
+	for _, t := range data {
+		tickers = append(tickers, t.ToTicker())
+	}
+	c.symbolsOrEmpty().Enrich(tickers)
+	return tickers
+}
+
+// tradableProducts returns the IDs of the online products quoted in one of the
+// comma-separated quote assets, or of every online product when quote is empty, using
+// the cached products metadata.
+func (c *Client) tradableProducts(quote string) ([]string, error) {
+	symbols, err := c.Symbols(common.SpotMarket)
+	if err != nil {
+		return nil, err
+	}
+
+	quotes := common.SplitList(quote)
+	tradable := make([]string, 0, len(symbols))
+	for id, info := range symbols {
+		if !info.Trading {
+			continue
+		}
+		if len(quotes) > 0 && !slices.Contains(quotes, info.QuoteAsset) {
+			continue
+		}
+		tradable = append(tradable, id)
+	}
+	return tradable, nil
+}
+
+// fetchTickers fetches the stats of the products with at most c.concurrency requests in
+// flight. Tickers are returned sorted by product ID; failed products are reported in a
+// *PartialError, or in a plain error when every product failed.
+func (c *Client) fetchTickers(products []string) ([]TickerData, error) {
+	type result struct {
+		ticker TickerData
+		err    error
+	}
+
+	results := make([]result, len(products))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < c.concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				stats, err := c.GetProductStats(products[i])
+				results[i] = result{ticker: stats.toTickerData(products[i]), err: err}
+			}
+		}()
+	}
+	for i := range products {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	tickers := make([]TickerData, 0, len(products))
+	failures := make(map[string]string)
+	for i, r := range results {
+		if r.err != nil {
+			failures[products[i]] = r.err.Error()
+			continue
+		}
+		tickers = append(tickers, r.ticker)
+	}
+	sort.Slice(tickers, func(i, j int) bool {
+		return tickers[i].Symbol < tickers[j].Symbol
+	})
+
+	if len(failures) > 0 && len(tickers) == 0 {
+		return nil, fmt.Errorf("fetching stats failed for every product: %v", &PartialError{Errors: failures})
+	}
+	if len(failures) > 0 {
+		return tickers, &PartialError{Errors: failures}
+	}
+	return tickers, nil
+}
+
+// fatal reports whether err is an error other than a *PartialError, which comes with the
+// tickers that could be fetched.
+func fatal(err error) bool {
+	var partial *PartialError
+	return err != nil && !errors.As(err, &partial)
+}
+
+// resolveProduct maps a symbol without a dash such as BTCUSD to its product ID using the
+// metadata. Other symbols are returned unchanged.
+func (c *Client) resolveProduct(symbol string) string {
+	symbols := c.symbolsOrEmpty()
+	if _, ok := symbols[symbol]; ok {
+		return symbol
+	}
+	for id, info := range symbols {
+		if info.BaseAsset+info.QuoteAsset == symbol {
+			return id
+		}
+	}
+	return symbol
+}
+
+// get performs a GET request against the Coinbase Exchange API and decodes the response into out.
+// Requests are paced by the client's pacer.
+func (c *Client) get(path string, out interface{}) error {
+	c.pacer.wait()
+
+	req, err := http.NewRequest("GET", c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("creating request failed: %v", err)
+	}
+	// Coinbase rejects requests without a user agent.
+	req.Header.Set("User-Agent", "CryptoGainerAPI-Client")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("executing request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("received non-OK response status: %s", res.Status)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response failed: %v", err)
+	}
+	return nil
+}
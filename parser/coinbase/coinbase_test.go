@@ -0,0 +1,131 @@
+package coinbase
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T) *Client {
+	return newCountingClient(t, new(int32))
+}
+
+// newCountingClient is newTestClient counting the stats requests in requests.
+func newCountingClient(t *testing.T, requests *int32) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") == "" {
+			t.Errorf("Expected a User-Agent header")
+		}
+
+		if strings.HasSuffix(r.URL.Path, "/stats") {
+			atomic.AddInt32(requests, 1)
+		}
+
+		var jsonResponse string
+		switch r.URL.Path {
+		case "/products":
+			jsonResponse = `[
+				{"id":"BTC-USD","base_currency":"BTC","quote_currency":"USD","quote_increment":"0.01","base_increment":"0.00000001","status":"online"},
+				{"id":"ETH-USD","base_currency":"ETH","quote_currency":"USD","quote_increment":"0.01","base_increment":"0.00000001","status":"online"},
+				{"id":"ETH-EUR","base_currency":"ETH","quote_currency":"EUR","quote_increment":"0.01","base_increment":"0.00000001","status":"online"},
+				{"id":"SOL-USD","base_currency":"SOL","quote_currency":"USD","quote_increment":"0.01","base_increment":"0.001","status":"online"},
+				{"id":"OLD-USD","base_currency":"OLD","quote_currency":"USD","quote_increment":"0.01","base_increment":"0.001","status":"delisted"}
+			]`
+		case "/products/BTC-USD/stats":
+			jsonResponse = `{"open":"100","high":"106","low":"99","last":"105","volume":"10","volume_30day":"300"}`
+		case "/products/ETH-USD/stats":
+			jsonResponse = `{"open":"100","high":"111","low":"98","last":"110","volume":"5","volume_30day":"150"}`
+		case "/products/ETH-EUR/stats":
+			jsonResponse = `{"open":"100","high":"121","low":"97","last":"120","volume":"1","volume_30day":"30"}`
+		case "/products/SOL-USD/stats":
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(jsonResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient("", "")
+	client.baseURL = server.URL
+	client.client = server.Client()
+	client.pacer.interval = 0
+	return client
+}
+
+func TestGet24HourTickerDataReportsPartialFailures(t *testing.T) {
+	client := newTestClient(t)
+
+	tickerData, err := client.Get24HourTickerData()
+	var partial *PartialError
+	if !errors.As(err, &partial) {
+		t.Fatalf("Expected a PartialError, but got %v", err)
+	}
+	if _, ok := partial.Errors["SOL-USD"]; !ok || len(partial.Errors) != 1 {
+		t.Errorf("Expected only SOL-USD to fail, but got %v", partial.Errors)
+	}
+	if len(tickerData) != 3 {
+		t.Fatalf("Expected 3 tickers, but got %d", len(tickerData))
+	}
+	if tickerData[0].Symbol != "BTC-USD" || tickerData[0].ChangePercent != 5 {
+		t.Errorf("Expected BTC-USD with a 5%% change, but got %+v", tickerData[0])
+	}
+}
+
+func TestGetTickersGainerForPairs(t *testing.T) {
+	client := newTestClient(t)
+
+	response, err := client.GetTickersGainerForPairs(0, "USD", "")
+	var partial *PartialError
+	if !errors.As(err, &partial) || len(partial.Errors) != 1 {
+		t.Fatalf("Expected SOL-USD to be reported in a PartialError, but got %v", err)
+	}
+	if len(response.Pairs) != 2 || response.Pairs[0] != "ETH/USD" || response.Pairs[1] != "BTC/USD" {
+		t.Errorf("Expected [ETH/USD BTC/USD], but got %v", response.Pairs)
+	}
+}
+
+func TestGetTickerForPair(t *testing.T) {
+	client := newTestClient(t)
+
+	ticker, err := client.GetTickerForPair("ETHEUR")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if ticker.Symbol != "ETH-EUR" {
+		t.Errorf("Expected product 'ETH-EUR', but got %s", ticker.Symbol)
+	}
+}
+
+func TestStatsCache(t *testing.T) {
+	var requests int32
+	client := newCountingClient(t, &requests)
+
+	client.Get24HourTickerData()
+	first := atomic.LoadInt32(&requests)
+	client.Get24HourTickerData()
+	// Only the failed SOL-USD stats are requested again.
+	if second := atomic.LoadInt32(&requests) - first; first != 4 || second != 1 {
+		t.Errorf("Expected 4 stats requests, then 1, but got %d and %d", first, second)
+	}
+}
+
+func TestPacer(t *testing.T) {
+	p := &pacer{interval: 20 * time.Millisecond}
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		p.wait()
+	}
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("Expected 4 requests to take at least 60ms, but took %v", elapsed)
+	}
+}
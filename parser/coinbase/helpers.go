@@ -0,0 +1,63 @@
+package coinbase
+
+import (
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// PartialError is returned alongside the fetched tickers when the stats of some products
+// could not be fetched. Errors is keyed by product ID.
+type PartialError = common.PartialError
+
+// toTickerData combines the product and its 24-hour statistics.
+func (s Stats) toTickerData(productID string) TickerData {
+	return TickerData{
+		Symbol:        productID,
+		OpenPrice:     s.Open,
+		HighPrice:     s.High,
+		LowPrice:      s.Low,
+		LastPrice:     s.Last,
+		Volume:        s.Volume,
+		Volume30Day:   s.Volume30Day,
+		ChangePercent: common.ChangePercent(common.ParseFloat(s.Open), common.ParseFloat(s.Last)),
+	}
+}
+
+// ToTicker converts the Coinbase ticker to the exchange-agnostic Ticker. The stats endpoint
+// has no top of book or quote volume, so the quote volume is estimated from the last price.
+func (t TickerData) ToTicker() common.Ticker {
+	base, quote := common.SplitSymbol(t.Symbol)
+	last := common.ParseFloat(t.LastPrice)
+	volume := common.ParseFloat(t.Volume)
+	now := time.Now().UTC()
+	return common.Ticker{
+		Exchange:      Name,
+		Market:        common.SpotMarket,
+		Symbol:        t.Symbol,
+		BaseAsset:     base,
+		QuoteAsset:    quote,
+		LastPrice:     last,
+		OpenPrice:     common.ParseFloat(t.OpenPrice),
+		HighPrice:     common.ParseFloat(t.HighPrice),
+		LowPrice:      common.ParseFloat(t.LowPrice),
+		Volume:        volume,
+		QuoteVolume:   volume * last,
+		ChangePercent: t.ChangePercent,
+		OpenTime:      now.Add(-24 * time.Hour),
+		CloseTime:     now,
+	}
+}
+
+// ToSymbolInfo converts the product to the exchange-agnostic SymbolInfo.
+func (p Product) ToSymbolInfo() common.SymbolInfo {
+	return common.SymbolInfo{
+		Symbol:     p.ID,
+		BaseAsset:  p.BaseCurrency,
+		QuoteAsset: p.QuoteCurrency,
+		Status:     p.Status,
+		Trading:    p.Status == "online" && !p.TradingDisabled,
+		TickSize:   common.ParseFloat(p.QuoteIncrement),
+		LotSize:    common.ParseFloat(p.BaseIncrement),
+	}
+}
@@ -0,0 +1,34 @@
+package coinbase
+
+// Product is the metadata of a Coinbase Exchange trading pair.
+type Product struct {
+	ID              string `json:"id"`
+	BaseCurrency    string `json:"base_currency"`
+	QuoteCurrency   string `json:"quote_currency"`
+	QuoteIncrement  string `json:"quote_increment"`
+	BaseIncrement   string `json:"base_increment"`
+	Status          string `json:"status"`
+	TradingDisabled bool   `json:"trading_disabled"`
+}
+
+// Stats is the 24-hour statistics of a product.
+type Stats struct {
+	Open        string `json:"open"`
+	High        string `json:"high"`
+	Low         string `json:"low"`
+	Last        string `json:"last"`
+	Volume      string `json:"volume"`
+	Volume30Day string `json:"volume_30day"`
+}
+
+// TickerData represents the 24-hour ticker data of a product.
+type TickerData struct {
+	Symbol        string  `json:"symbol"`
+	OpenPrice     string  `json:"openPrice"`
+	HighPrice     string  `json:"highPrice"`
+	LowPrice      string  `json:"lowPrice"`
+	LastPrice     string  `json:"lastPrice"`
+	Volume        string  `json:"volume"`
+	Volume30Day   string  `json:"volume30Day"`
+	ChangePercent float64 `json:"changePercent"`
+}
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SymbolError reports a symbol of a multi-symbol request that could not be returned.
+type SymbolError struct {
+	Symbol string `json:"symbol"`
+	Error  string `json:"error"`
+}
+
+// TickerBatch is the response of a multi-symbol ticker request: the tickers found, in
+// request order, and an error for every other symbol.
+type TickerBatch struct {
+	Tickers interface{}   `json:"tickers"`
+	Errors  []SymbolError `json:"errors,omitempty"`
+}
+
+// UnknownSymbol returns the SymbolError of a symbol the market does not list.
+func UnknownSymbol(symbol string) SymbolError {
+	return SymbolError{Symbol: symbol, Error: fmt.Errorf("%w: %s", ErrUnknownSymbol, symbol).Error()}
+}
+
+// UniqueSymbols returns the upper-cased symbols without duplicates, in request order.
+func UniqueSymbols(symbols []string) []string {
+	seen := make(map[string]bool, len(symbols))
+	unique := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		symbol = strings.ToUpper(strings.TrimSpace(symbol))
+		if symbol == "" || seen[symbol] {
+			continue
+		}
+		seen[symbol] = true
+		unique = append(unique, symbol)
+	}
+	return unique
+}
+
+// PickSymbols returns the items of the requested symbols, in request order, and an
+// UnknownSymbol error for every requested symbol that has no item. It lets an exchange
+// client answer a multi-symbol request from a bulk response.
+func PickSymbols[T any](items []T, symbol func(T) string, requested []string) ([]T, []SymbolError) {
+	bySymbol := make(map[string]T, len(items))
+	for _, item := range items {
+		bySymbol[strings.ToUpper(symbol(item))] = item
+	}
+
+	picked := make([]T, 0, len(requested))
+	var errs []SymbolError
+	for _, s := range UniqueSymbols(requested) {
+		if item, ok := bySymbol[s]; ok {
+			picked = append(picked, item)
+		} else {
+			errs = append(errs, UnknownSymbol(s))
+		}
+	}
+	return picked, errs
+}
@@ -0,0 +1,174 @@
+package common
+
+import "time"
+
+// Candle is the exchange-agnostic representation of a kline: the prices and volumes of
+// one interval starting at OpenTime.
+type Candle struct {
+	OpenTime    time.Time `json:"open_time"`
+	Open        float64   `json:"open"`
+	High        float64   `json:"high"`
+	Low         float64   `json:"low"`
+	Close       float64   `json:"close"`
+	Volume      float64   `json:"volume"`
+	QuoteVolume float64   `json:"quote_volume"`
+}
+
+// Interval is a candle interval, named as Binance names it, e.g. "15m", "4h" or "1d".
+type Interval string
+
+const (
+	Interval1m  Interval = "1m"
+	Interval3m  Interval = "3m"
+	Interval5m  Interval = "5m"
+	Interval15m Interval = "15m"
+	Interval30m Interval = "30m"
+	Interval1h  Interval = "1h"
+	Interval2h  Interval = "2h"
+	Interval4h  Interval = "4h"
+	Interval6h  Interval = "6h"
+	Interval12h Interval = "12h"
+	Interval1d  Interval = "1d"
+	Interval1w  Interval = "1w"
+	Interval1M  Interval = "1M"
+)
+
+// Candle query defaults and bounds. Longer histories are fetched in several requests of
+// at most the exchange's per-request maximum.
+const (
+	DefaultCandleInterval = Interval1h
+	DefaultCandleLimit    = 500
+	MaxCandleLimit        = 10000
+)
+
+// intervals maps every supported interval to its length; a month counts as 30 days.
+var intervals = map[Interval]time.Duration{
+	Interval1m:  time.Minute,
+	Interval3m:  3 * time.Minute,
+	Interval5m:  5 * time.Minute,
+	Interval15m: 15 * time.Minute,
+	Interval30m: 30 * time.Minute,
+	Interval1h:  time.Hour,
+	Interval2h:  2 * time.Hour,
+	Interval4h:  4 * time.Hour,
+	Interval6h:  6 * time.Hour,
+	Interval12h: 12 * time.Hour,
+	Interval1d:  24 * time.Hour,
+	Interval1w:  7 * 24 * time.Hour,
+	Interval1M:  30 * 24 * time.Hour,
+}
+
+// IsValidInterval reports whether interval is a supported candle interval.
+func IsValidInterval(interval Interval) bool {
+	_, ok := intervals[interval]
+	return ok
+}
+
+// Duration returns the length of the interval, or zero for an unsupported interval.
+func (i Interval) Duration() time.Duration {
+	return intervals[i]
+}
+
+// CloseTime returns the close time of the candle of the interval opening at open. Monthly
+// candles close at the same time of the next calendar month.
+func (i Interval) CloseTime(open time.Time) time.Time {
+	if i == Interval1M {
+		return open.AddDate(0, 1, 0)
+	}
+	return open.Add(i.Duration())
+}
+
+// ClosedCandles returns the candles of the interval that closed by now, dropping the still
+// open latest candle, so that values computed from them do not change within an interval.
+func ClosedCandles(candles []Candle, interval Interval, now time.Time) []Candle {
+	for len(candles) > 0 && interval.CloseTime(candles[len(candles)-1].OpenTime).After(now) {
+		candles = candles[:len(candles)-1]
+	}
+	return candles
+}
+
+// CandleQuery selects the candles of a trading pair. Without Start, the Limit candles
+// opening at or before End (default now) are returned; with Start, the first Limit
+// candles opening at or after Start and not after End. A zero Limit selects
+// DefaultCandleLimit and larger limits are capped at MaxCandleLimit.
+type CandleQuery struct {
+	Interval Interval
+	Start    time.Time
+	End      time.Time
+	Limit    int
+}
+
+// CandlePage requests at most limit candles opening at or before end, or the latest ones
+// when end is zero, oldest first.
+type CandlePage func(end time.Time, limit int) ([]Candle, error)
+
+// FetchCandles returns the candles selected by the query, oldest first, requesting pages
+// of at most pageLimit candles backwards in time until the query is satisfied or the
+// exchange has no older candles.
+func FetchCandles(query CandleQuery, pageLimit int, page CandlePage) ([]Candle, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultCandleLimit
+	}
+	limit = min(limit, MaxCandleLimit)
+
+	end := query.End
+	if !query.Start.IsZero() {
+		// Bound the range so that paging backwards from its end starts just after the
+		// first limit candles.
+		last := query.Start.Add(time.Duration(limit-1) * query.Interval.Duration())
+		if end.IsZero() || last.Before(end) {
+			end = last
+		}
+	}
+
+	candles := make([]Candle, 0)
+	for len(candles) < limit {
+		requested := min(limit-len(candles), pageLimit)
+		fetched, err := page(end, requested)
+		if err != nil {
+			return nil, err
+		}
+
+		older := fetched
+		for len(older) > 0 && !query.Start.IsZero() && older[0].OpenTime.Before(query.Start) {
+			older = older[1:]
+		}
+		candles = append(older, candles...)
+		if len(older) < requested || len(older) == 0 {
+			break
+		}
+		end = older[0].OpenTime.Add(-time.Millisecond)
+	}
+
+	return candles, nil
+}
+
+// CandleTicker returns the statistics of consecutive candles, oldest first, as the ticker
+// of the window they cover: the first open, the last close as last price, the high and
+// low over all candles and the summed volumes. It reports false without candles.
+func CandleTicker(symbol string, candles []Candle) (Ticker, bool) {
+	if len(candles) == 0 {
+		return Ticker{}, false
+	}
+
+	first, last := candles[0], candles[len(candles)-1]
+	t := Ticker{
+		Symbol:    symbol,
+		OpenPrice: first.Open,
+		LastPrice: last.Close,
+		HighPrice: first.High,
+		LowPrice:  first.Low,
+		OpenTime:  first.OpenTime,
+	}
+	for _, candle := range candles {
+		t.HighPrice = max(t.HighPrice, candle.High)
+		t.LowPrice = min(t.LowPrice, candle.Low)
+		t.Volume += candle.Volume
+		t.QuoteVolume += candle.QuoteVolume
+	}
+	if t.OpenPrice > 0 {
+		t.ChangePercent = (t.LastPrice - t.OpenPrice) / t.OpenPrice * 100
+	}
+	return t, true
+}
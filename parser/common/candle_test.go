@@ -0,0 +1,108 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFetchCandles(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var history []Candle
+	for i := 0; i < 25; i++ {
+		history = append(history, Candle{OpenTime: start.Add(time.Duration(i) * time.Hour), Close: float64(i)})
+	}
+	// page serves the exchange contract: the latest candles opening at or before end.
+	requests := 0
+	page := func(end time.Time, limit int) ([]Candle, error) {
+		requests++
+		var candles []Candle
+		for _, candle := range history {
+			if end.IsZero() || !candle.OpenTime.After(end) {
+				candles = append(candles, candle)
+			}
+		}
+		if len(candles) > limit {
+			candles = candles[len(candles)-limit:]
+		}
+		return candles, nil
+	}
+
+	tests := []struct {
+		name       string
+		query      CandleQuery
+		first      float64
+		count      int
+		pageLimits int
+	}{
+		{"latest", CandleQuery{Interval: Interval1h, Limit: 12}, 13, 12, 3},
+		{"before end", CandleQuery{Interval: Interval1h, End: start.Add(9 * time.Hour), Limit: 4}, 6, 4, 1},
+		{"from start", CandleQuery{Interval: Interval1h, Start: start.Add(2 * time.Hour), Limit: 7}, 2, 7, 2},
+		{"whole history", CandleQuery{Interval: Interval1h, Limit: 100}, 0, 25, 5},
+		{"start to end", CandleQuery{Interval: Interval1h, Start: start.Add(20 * time.Hour), End: start.Add(22 * time.Hour), Limit: 100}, 20, 3, 1},
+	}
+
+	for _, tt := range tests {
+		requests = 0
+		candles, err := FetchCandles(tt.query, 5, page)
+		if err != nil {
+			t.Fatalf("%s: expected no error, but got %v", tt.name, err)
+		}
+		if len(candles) != tt.count {
+			t.Fatalf("%s: expected %d candles, but got %d", tt.name, tt.count, len(candles))
+		}
+		if candles[0].Close != tt.first {
+			t.Errorf("%s: expected candle %v first, but got %v", tt.name, tt.first, candles[0].Close)
+		}
+		for i := 1; i < len(candles); i++ {
+			if !candles[i].OpenTime.After(candles[i-1].OpenTime) {
+				t.Errorf("%s: expected candles oldest first without duplicates, but got %v after %v", tt.name, candles[i].OpenTime, candles[i-1].OpenTime)
+			}
+		}
+		if requests > tt.pageLimits+1 {
+			t.Errorf("%s: expected at most %d requests, but got %d", tt.name, tt.pageLimits+1, requests)
+		}
+	}
+}
+
+func TestCandleTicker(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := []Candle{
+		{OpenTime: start, Open: 100, High: 104, Low: 99, Close: 103, Volume: 2, QuoteVolume: 205},
+		{OpenTime: start.Add(time.Hour), Open: 103, High: 112, Low: 97, Close: 110, Volume: 3, QuoteVolume: 320},
+	}
+
+	ticker, ok := CandleTicker("BTCUSDT", candles)
+	if !ok {
+		t.Fatalf("Expected a ticker, but got none")
+	}
+	if ticker.OpenPrice != 100 || ticker.LastPrice != 110 || ticker.HighPrice != 112 || ticker.LowPrice != 97 {
+		t.Errorf("Expected open 100, last 110, high 112 and low 97, but got %+v", ticker)
+	}
+	if ticker.Volume != 5 || ticker.QuoteVolume != 525 || ticker.ChangePercent != 10 {
+		t.Errorf("Expected volume 5, quote volume 525 and change 10, but got %+v", ticker)
+	}
+	if !ticker.OpenTime.Equal(start) {
+		t.Errorf("Expected open time %v, but got %v", start, ticker.OpenTime)
+	}
+
+	if _, ok := CandleTicker("BTCUSDT", nil); ok {
+		t.Errorf("Expected no ticker without candles")
+	}
+}
+
+func TestClosedCandles(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := []Candle{{OpenTime: start}, {OpenTime: start.Add(time.Hour)}, {OpenTime: start.Add(2 * time.Hour)}}
+
+	if got := ClosedCandles(candles, Interval1h, start.Add(150*time.Minute)); len(got) != 2 {
+		t.Errorf("Expected the open candle to be dropped, but got %d candles", len(got))
+	}
+	if got := ClosedCandles(candles, Interval1h, start.Add(3*time.Hour)); len(got) != 3 {
+		t.Errorf("Expected every candle once the last one closed, but got %d candles", len(got))
+	}
+
+	monthly := []Candle{{OpenTime: start}, {OpenTime: start.AddDate(0, 1, 0)}}
+	if got := ClosedCandles(monthly, Interval1M, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)); len(got) != 2 {
+		t.Errorf("Expected February to close on March 1, but got %d candles", len(got))
+	}
+}
@@ -0,0 +1,78 @@
+package common
+
+import "sync"
+
+// CheckEach returns a GainersQuery check that keeps the tickers for which keep reports
+// true, calling keep for at most concurrency tickers at a time. A ticker whose keep
+// fails is dropped, and the returned error function reports the failures in a
+// *PartialError once the ranking is done, or nil when every check succeeded.
+func CheckEach(concurrency int, keep func(Ticker) (bool, error)) (func([]Ticker) []bool, func() error) {
+	var mu sync.Mutex
+	failures := make(map[string]string)
+
+	check := func(tickers []Ticker) []bool {
+		kept := make([]bool, len(tickers))
+		jobs := make(chan int)
+		var wg sync.WaitGroup
+		for w := 0; w < concurrency; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range jobs {
+					ok, err := keep(tickers[i])
+					if err != nil {
+						mu.Lock()
+						failures[tickers[i].Symbol] = err.Error()
+						mu.Unlock()
+						continue
+					}
+					kept[i] = ok
+				}
+			}()
+		}
+		for i := range tickers {
+			jobs <- i
+		}
+		close(jobs)
+		wg.Wait()
+		return kept
+	}
+
+	return check, func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(failures) == 0 {
+			return nil
+		}
+		return &PartialError{Errors: failures}
+	}
+}
+
+// ChainChecks returns a GainersQuery check that keeps the tickers kept by both checks,
+// calling second only for the tickers first keeps. A nil check keeps every ticker.
+func ChainChecks(first, second func([]Ticker) []bool) func([]Ticker) []bool {
+	if first == nil {
+		return second
+	}
+	if second == nil {
+		return first
+	}
+	return func(tickers []Ticker) []bool {
+		kept := first(tickers)
+		var passed []Ticker
+		var index []int
+		for i, ok := range kept {
+			if ok {
+				passed = append(passed, tickers[i])
+				index = append(index, i)
+			}
+		}
+		if len(passed) == 0 {
+			return kept
+		}
+		for i, ok := range second(passed) {
+			kept[index[i]] = ok
+		}
+		return kept
+	}
+}
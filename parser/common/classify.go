@@ -0,0 +1,149 @@
+package common
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Category tags a class of trading pair that most strategies skip.
+type Category string
+
+const (
+	// CategoryLeveraged tags leveraged tokens such as BTCUP, ETHBEAR or BTC3L.
+	CategoryLeveraged Category = "leveraged"
+	// CategoryStablePair tags stablecoins quoted in another stablecoin or a fiat
+	// currency, such as USDCUSDT or USDTTRY.
+	CategoryStablePair Category = "stable_pair"
+	// CategoryFiatPair tags fiat currencies traded against a quote, such as EURUSDT.
+	CategoryFiatPair Category = "fiat_pair"
+)
+
+// stablecoins lists the fiat-backed and fiat-pegged stablecoins.
+var stablecoins = map[string]bool{
+	"USDT": true, "USDC": true, "BUSD": true, "TUSD": true, "FDUSD": true, "DAI": true,
+	"USDP": true, "PYUSD": true, "USDD": true, "USDE": true, "USD1": true, "RLUSD": true,
+	"GUSD": true, "LUSD": true, "FRAX": true, "USDS": true, "EURC": true, "EURT": true,
+	"EURI": true, "AEUR": true,
+}
+
+// fiatCurrencies lists the fiat currencies listed as base or quote assets.
+var fiatCurrencies = map[string]bool{
+	"USD": true, "EUR": true, "GBP": true, "TRY": true, "BRL": true, "AUD": true,
+	"JPY": true, "RUB": true, "UAH": true, "ZAR": true, "PLN": true, "RON": true,
+	"ARS": true, "MXN": true, "IDR": true, "NGN": true, "CAD": true, "CHF": true,
+	"KRW": true, "CZK": true, "COP": true,
+}
+
+// leveragedSuffixes are the base asset suffixes of leveraged tokens.
+var leveragedSuffixes = []string{"DOWN", "BULL", "BEAR", "UP"}
+
+// leveragedUnderlyings lists the underlyings leveraged tokens are issued on, used to tell
+// leveraged tokens from assets that merely end in a suffix, e.g. SYRUP or JUP, when no
+// base assets are known.
+var leveragedUnderlyings = map[string]bool{
+	"BTC": true, "ETH": true, "BNB": true, "XRP": true, "ADA": true, "DOT": true,
+	"LINK": true, "LTC": true, "BCH": true, "EOS": true, "TRX": true, "XTZ": true,
+	"XLM": true, "FIL": true, "SXP": true, "UNI": true, "SUSHI": true, "AAVE": true,
+	"YFI": true, "1INCH": true, "SOL": true, "DOGE": true, "AVAX": true, "MATIC": true,
+}
+
+// leveragedMultiple matches leveraged tokens named by their multiple, e.g. BTC3L or ETH5S.
+var leveragedMultiple = regexp.MustCompile(`^([A-Z0-9]+?)\d+[LS]$`)
+
+// IsStablecoin reports whether the asset is a stablecoin.
+func IsStablecoin(asset string) bool {
+	return stablecoins[strings.ToUpper(asset)]
+}
+
+// IsFiat reports whether the asset is a fiat currency.
+func IsFiat(asset string) bool {
+	return fiatCurrencies[strings.ToUpper(asset)]
+}
+
+// IsLeveragedToken reports whether the base asset is a leveraged token: a leveraged
+// suffix or multiple appended to an underlying listed in bases. Without bases the
+// underlying must be one of the leveragedUnderlyings, which tells BTCUP from SYRUP.
+func IsLeveragedToken(base string, bases map[string]bool) bool {
+	base = strings.ToUpper(base)
+	underlying := ""
+	if m := leveragedMultiple.FindStringSubmatch(base); m != nil {
+		underlying = m[1]
+	} else {
+		for _, suffix := range leveragedSuffixes {
+			if strings.HasSuffix(base, suffix) {
+				underlying = strings.TrimSuffix(base, suffix)
+				break
+			}
+		}
+	}
+	if underlying == "" {
+		return false
+	}
+	if len(bases) > 0 {
+		return bases[underlying]
+	}
+	return leveragedUnderlyings[underlying]
+}
+
+// Classify returns the categories of the pair of base and quote asset; see IsLeveragedToken
+// for bases.
+func Classify(base, quote string, bases map[string]bool) []Category {
+	var categories []Category
+	if IsLeveragedToken(base, bases) {
+		categories = append(categories, CategoryLeveraged)
+	}
+	if IsStablecoin(base) && (IsStablecoin(quote) || IsFiat(quote)) {
+		categories = append(categories, CategoryStablePair)
+	}
+	if IsFiat(base) {
+		categories = append(categories, CategoryFiatPair)
+	}
+	return categories
+}
+
+// HasCategory reports whether categories contains category.
+func HasCategory(categories []Category, category Category) bool {
+	for _, c := range categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// Classify tags every symbol with the categories of its base and quote asset, taking
+// the underlyings of leveraged tokens from the base assets of the metadata.
+func (s Symbols) Classify() {
+	bases := make(map[string]bool, len(s))
+	for _, info := range s {
+		bases[strings.ToUpper(info.BaseAsset)] = true
+	}
+	for symbol, info := range s {
+		info.Categories = Classify(info.BaseAsset, info.QuoteAsset, bases)
+		s[symbol] = info
+	}
+}
+
+// baseAssets returns the base assets of the tickers.
+func baseAssets(tickers []Ticker) map[string]bool {
+	bases := make(map[string]bool, len(tickers))
+	for _, t := range tickers {
+		if t.BaseAsset != "" {
+			bases[strings.ToUpper(t.BaseAsset)] = true
+		}
+	}
+	return bases
+}
+
+// categories returns the categories the metadata tagged the ticker with, classifying it
+// against the given base assets when it was not enriched.
+func categories(t Ticker, bases map[string]bool) []Category {
+	if t.Categories != nil {
+		return t.Categories
+	}
+	base, quote := t.BaseAsset, t.QuoteAsset
+	if base == "" {
+		base, quote = SplitSymbol(t.Symbol)
+	}
+	return Classify(base, quote, bases)
+}
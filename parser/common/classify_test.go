@@ -0,0 +1,87 @@
+package common
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	bases := map[string]bool{"BTC": true, "ETH": true, "JUP": true, "SYRUP": true}
+
+	tests := []struct {
+		base, quote string
+		want        []Category
+	}{
+		{"BTC", "USDT", nil},
+		{"BTCUP", "USDT", []Category{CategoryLeveraged}},
+		{"ETHDOWN", "USDT", []Category{CategoryLeveraged}},
+		{"ETHBULL", "USDT", []Category{CategoryLeveraged}},
+		{"BTC3L", "USDT", []Category{CategoryLeveraged}},
+		{"ETH3S", "USDT", []Category{CategoryLeveraged}},
+		{"JUP", "USDT", nil},
+		{"SYRUP", "USDT", nil},
+		{"USDC", "USDT", []Category{CategoryStablePair}},
+		{"FDUSD", "USDT", []Category{CategoryStablePair}},
+		{"USDT", "TRY", []Category{CategoryStablePair}},
+		{"EUR", "USDT", []Category{CategoryFiatPair}},
+		{"TRY", "USDT", []Category{CategoryFiatPair}},
+		{"BTC", "EUR", nil},
+	}
+
+	for _, tt := range tests {
+		got := Classify(tt.base, tt.quote, bases)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s/%s: expected %v, but got %v", tt.base, tt.quote, tt.want, got)
+			continue
+		}
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s/%s: expected %v, but got %v", tt.base, tt.quote, tt.want, got)
+			}
+		}
+	}
+}
+
+func TestClassifyWithoutBases(t *testing.T) {
+	tests := []struct {
+		base      string
+		leveraged bool
+	}{
+		{"BTCUP", true},
+		{"ETHDOWN", true},
+		{"ETHBULL", true},
+		{"XRPBEAR", true},
+		{"BTC3L", true},
+		{"ETH3S", true},
+		{"SYRUP", false},
+		{"JUP", false},
+		{"SETUP", false},
+		{"GRASSBULL", false},
+		{"PEPE5L", false},
+	}
+
+	for _, tt := range tests {
+		got := HasCategory(Classify(tt.base, "USDT", nil), CategoryLeveraged)
+		if got != tt.leveraged {
+			t.Errorf("%s: expected leveraged %v, but got %v", tt.base, tt.leveraged, got)
+		}
+	}
+}
+
+func TestRankExcludeCategories(t *testing.T) {
+	tickers := []Ticker{
+		{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", ChangePercent: 1},
+		{Symbol: "BTCUPUSDT", BaseAsset: "BTCUP", QuoteAsset: "USDT", ChangePercent: 9},
+		{Symbol: "USDCUSDT", BaseAsset: "USDC", QuoteAsset: "USDT", ChangePercent: 0.01},
+		{Symbol: "EURUSDT", BaseAsset: "EUR", QuoteAsset: "USDT", ChangePercent: 0.5},
+	}
+
+	query := GainersQuery{ExcludeLeveraged: true, ExcludeStablePairs: true, ExcludeFiatPairs: true}
+	got := Rank(tickers, RankingGainers, query)
+	if len(got) != 1 || got[0].Symbol != "BTCUSDT" {
+		t.Errorf("Expected only BTCUSDT, but got %+v", got)
+	}
+
+	program, _ := ParseFilter(`leveraged || fiat_pair`)
+	got = Rank(tickers, RankingGainers, GainersQuery{Filter: program})
+	if len(got) != 2 || got[0].Symbol != "BTCUPUSDT" || got[1].Symbol != "EURUSDT" {
+		t.Errorf("Expected BTCUPUSDT and EURUSDT, but got %+v", got)
+	}
+}
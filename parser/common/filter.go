@@ -0,0 +1,112 @@
+package common
+
+import "github.com/cploutarchou/CryptoGainerAPI-Client/parser/filter"
+
+// FilterVars are the ticker fields a filter expression may reference.
+var FilterVars = filter.Vars{
+	"exchange":            filter.String,
+	"market":              filter.String,
+	"symbol":              filter.String,
+	"base":                filter.String,
+	"quote":               filter.String,
+	"price":               filter.Number,
+	"open":                filter.Number,
+	"high":                filter.Number,
+	"low":                 filter.Number,
+	"bid":                 filter.Number,
+	"ask":                 filter.Number,
+	"change_pct":          filter.Number,
+	"range_pct":           filter.Number,
+	"spread_pct":          filter.Number,
+	"tick_pct":            filter.Number,
+	"volume":              filter.Number,
+	"quote_volume":        filter.Number,
+	"usd_volume":          filter.Number,
+	"trades":              filter.Number,
+	"mark_price":          filter.Number,
+	"index_price":         filter.Number,
+	"funding_rate":        filter.Number,
+	"open_interest":       filter.Number,
+	"open_interest_value": filter.Number,
+	"basis":               filter.Number,
+	"leveraged":           filter.Bool,
+	"stable_pair":         filter.Bool,
+	"fiat_pair":           filter.Bool,
+}
+
+// ParseFilter parses a filter expression over the FilterVars ticker fields, e.g.
+// `quote == "USDT" && change_pct > 5 && !(base in ["BNB", "FDUSD"])`. Parse errors are
+// returned as *filter.Error pointing at the offending token.
+func ParseFilter(expression string) (*filter.Program, error) {
+	return filter.Parse(expression, FilterVars)
+}
+
+// tickerLookup returns the FilterVars values of the ticker, converting its quote volume
+// to USD with the given rates and classifying it against the given base assets. Fields
+// the exchange does not report are zero.
+func tickerLookup(t Ticker, rates map[string]float64, bases map[string]bool) filter.Lookup {
+	return func(name string) interface{} {
+		switch name {
+		case "exchange":
+			return t.Exchange
+		case "market":
+			return t.Market
+		case "symbol":
+			return t.Symbol
+		case "base":
+			return t.BaseAsset
+		case "quote":
+			return t.QuoteAsset
+		case "price":
+			return t.LastPrice
+		case "open":
+			return t.OpenPrice
+		case "high":
+			return t.HighPrice
+		case "low":
+			return t.LowPrice
+		case "bid":
+			return t.BidPrice
+		case "ask":
+			return t.AskPrice
+		case "change_pct":
+			return t.ChangePercent
+		case "range_pct":
+			return RangePercent(t)
+		case "spread_pct":
+			spread, _ := SpreadPercent(t)
+			return spread
+		case "tick_pct":
+			tick, _ := TickPercent(t)
+			return tick
+		case "volume":
+			return t.Volume
+		case "quote_volume":
+			return t.QuoteVolume
+		case "usd_volume":
+			return usdVolume(t, rates)
+		case "trades":
+			return float64(t.TradeCount)
+		case "mark_price":
+			return t.MarkPrice
+		case "index_price":
+			return t.IndexPrice
+		case "funding_rate":
+			return t.FundingRate
+		case "open_interest":
+			return t.OpenInterest
+		case "open_interest_value":
+			return t.OpenInterestValue
+		case "basis":
+			return t.Basis
+		case "leveraged":
+			return HasCategory(categories(t, bases), CategoryLeveraged)
+		case "stable_pair":
+			return HasCategory(categories(t, bases), CategoryStablePair)
+		case "fiat_pair":
+			return HasCategory(categories(t, bases), CategoryFiatPair)
+		default:
+			return nil
+		}
+	}
+}
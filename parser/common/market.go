@@ -0,0 +1,17 @@
+package common
+
+import "fmt"
+
+// SpotMarket is the market type of spot trading, the only market of spot-only exchanges.
+const SpotMarket = "spot"
+
+// RequireMarket returns an error when market is not one of the markets served by an
+// exchange.
+func RequireMarket(market string, markets ...string) error {
+	for _, m := range markets {
+		if market == m {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid market type: %s", market)
+}
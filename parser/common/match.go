@@ -0,0 +1,122 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MatchMode selects how the values of an include or exclude list are matched against a ticker.
+type MatchMode string
+
+const (
+	// MatchContains matches symbols containing any value.
+	MatchContains MatchMode = "contains"
+	// MatchBase matches tickers whose base asset equals any value.
+	MatchBase MatchMode = "base"
+	// MatchPrefix matches symbols starting with any value.
+	MatchPrefix MatchMode = "prefix"
+	// MatchSuffix matches symbols ending with any value.
+	MatchSuffix MatchMode = "suffix"
+	// MatchRegex matches symbols against a single regular expression.
+	MatchRegex MatchMode = "regex"
+)
+
+// Default match modes of the include and exclude lists. Exclusions match substrings, as
+// the single exclude filter always did.
+const (
+	DefaultIncludeMode = MatchBase
+	DefaultExcludeMode = MatchContains
+)
+
+// Matcher matches tickers against a list of values in one match mode.
+type Matcher struct {
+	mode   MatchMode
+	values []string
+	re     *regexp.Regexp
+}
+
+// NewMatcher returns the matcher of a comma-separated list of values, e.g. "BNB,FDUSD".
+// In MatchRegex mode the whole value is one case-insensitive pattern; use | for
+// alternatives. Values of the other modes are matched case-insensitively. An empty mode
+// selects fallback.
+func NewMatcher(mode MatchMode, value string, fallback MatchMode) (Matcher, error) {
+	if mode == "" {
+		mode = fallback
+	}
+	m := Matcher{mode: mode}
+	switch mode {
+	case MatchContains, MatchBase, MatchPrefix, MatchSuffix:
+		m.values = SplitList(value)
+	case MatchRegex:
+		if value == "" {
+			return m, nil
+		}
+		re, err := regexp.Compile("(?i)" + value)
+		if err != nil {
+			return Matcher{}, fmt.Errorf("invalid pattern: %v", err)
+		}
+		m.re = re
+	default:
+		return Matcher{}, fmt.Errorf("invalid match mode: %s", mode)
+	}
+	return m, nil
+}
+
+// IsValidMatchMode reports whether mode is a supported match mode; "" selects the default.
+func IsValidMatchMode(mode MatchMode) bool {
+	switch mode {
+	case "", MatchContains, MatchBase, MatchPrefix, MatchSuffix, MatchRegex:
+		return true
+	default:
+		return false
+	}
+}
+
+// Empty reports whether the matcher has no values, in which case include lists keep and
+// exclude lists drop nothing.
+func (m Matcher) Empty() bool {
+	return len(m.values) == 0 && m.re == nil
+}
+
+// Match reports whether the ticker matches any value of the list.
+func (m Matcher) Match(t Ticker) bool {
+	if m.re != nil {
+		return m.re.MatchString(t.Symbol)
+	}
+
+	symbol := strings.ToUpper(t.Symbol)
+	base := strings.ToUpper(t.BaseAsset)
+	if base == "" {
+		base, _ = SplitSymbol(symbol)
+	}
+	for _, value := range m.values {
+		var ok bool
+		switch m.mode {
+		case MatchBase:
+			ok = base == value
+		case MatchPrefix:
+			ok = strings.HasPrefix(symbol, value)
+		case MatchSuffix:
+			ok = strings.HasSuffix(symbol, value)
+		default:
+			ok = strings.Contains(symbol, value)
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitList splits a comma-separated list, trimming and upper-casing every value and
+// dropping empty ones.
+func SplitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.ToUpper(strings.TrimSpace(v)); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,45 @@
+package common
+
+import "testing"
+
+func TestMatcher(t *testing.T) {
+	tickers := []Ticker{
+		{Symbol: "BNBUSDT", BaseAsset: "BNB", QuoteAsset: "USDT"},
+		{Symbol: "WBNBUSDT", BaseAsset: "WBNB", QuoteAsset: "USDT"},
+		{Symbol: "BTCBNB"},
+		{Symbol: "ETHUP", BaseAsset: "ETHUP", QuoteAsset: "USDT"},
+	}
+
+	tests := []struct {
+		mode  MatchMode
+		value string
+		want  []bool
+	}{
+		{MatchContains, "BNB", []bool{true, true, true, false}},
+		{MatchBase, "bnb, wbnb", []bool{true, true, false, false}},
+		{MatchBase, "BTC", []bool{false, false, true, false}},
+		{MatchPrefix, "BNB,ETH", []bool{true, false, false, true}},
+		{MatchSuffix, "BNB", []bool{false, false, true, false}},
+		{MatchRegex, "^(W?BNB)USDT$|UP$", []bool{true, true, false, true}},
+		{MatchContains, "", []bool{false, false, false, false}},
+	}
+
+	for _, tt := range tests {
+		m, err := NewMatcher(tt.mode, tt.value, DefaultExcludeMode)
+		if err != nil {
+			t.Fatalf("%s %q: expected no error, but got %v", tt.mode, tt.value, err)
+		}
+		for i, ticker := range tickers {
+			if got := m.Match(ticker); got != tt.want[i] {
+				t.Errorf("%s %q: expected %v for %s, but got %v", tt.mode, tt.value, tt.want[i], ticker.Symbol, got)
+			}
+		}
+	}
+
+	if _, err := NewMatcher(MatchRegex, "(", DefaultExcludeMode); err == nil {
+		t.Error("Expected an error for an invalid pattern, but got nil")
+	}
+	if _, err := NewMatcher("glob", "BNB", DefaultExcludeMode); err == nil {
+		t.Error("Expected an error for an invalid mode, but got nil")
+	}
+}
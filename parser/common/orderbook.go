@@ -0,0 +1,154 @@
+package common
+
+import "time"
+
+// DefaultDepthLimit is the number of levels per side requested for an order book.
+const DefaultDepthLimit = 500
+
+// DepthPercents are the distances from the mid price, in percent, that depth is measured within.
+var DepthPercents = []float64{0.5, 1, 2}
+
+// Level is a price level of an order book.
+type Level struct {
+	Price    float64 `json:"price"`
+	Quantity float64 `json:"quantity"`
+}
+
+// OrderBook is the exchange-agnostic representation of an order book snapshot, best
+// levels first. It holds only the levels the exchange returned, so depth far from the
+// mid price may be understated.
+type OrderBook struct {
+	Exchange string    `json:"exchange"`
+	Market   string    `json:"market"`
+	Symbol   string    `json:"symbol"`
+	Bids     []Level   `json:"bids"`
+	Asks     []Level   `json:"asks"`
+	Time     time.Time `json:"time"`
+}
+
+// Depth is the quote notional resting within Percent of the mid price on each side.
+type Depth struct {
+	Percent float64 `json:"percent"`
+	Bid     float64 `json:"bid"`
+	Ask     float64 `json:"ask"`
+}
+
+// Slippage is the estimated cost of a market order of Notional in the quote asset: the
+// average fill price of a buy and a sell and their distance from the mid price in basis
+// points. Values are nil when the book is too thin to fill the order.
+type Slippage struct {
+	Notional  float64  `json:"notional"`
+	BuyPrice  *float64 `json:"buy_price"`
+	BuyBps    *float64 `json:"buy_bps"`
+	SellPrice *float64 `json:"sell_price"`
+	SellBps   *float64 `json:"sell_bps"`
+}
+
+// Liquidity are the liquidity metrics of an order book.
+type Liquidity struct {
+	Mid       float64   `json:"mid"`
+	SpreadBps float64   `json:"spread_bps"`
+	Depth     []Depth   `json:"depth"`
+	Slippage  *Slippage `json:"slippage,omitempty"`
+}
+
+// DepthReport is an order book with its liquidity metrics.
+type DepthReport struct {
+	OrderBook
+	Metrics Liquidity `json:"metrics"`
+}
+
+// Mid returns the mid price of the best bid and ask. It reports false when a side is empty.
+func (b OrderBook) Mid() (float64, bool) {
+	if len(b.Bids) == 0 || len(b.Asks) == 0 {
+		return 0, false
+	}
+	return (b.Bids[0].Price + b.Asks[0].Price) / 2, true
+}
+
+// Depth returns the quote notional of the bids and asks within percent of the mid price.
+func (b OrderBook) Depth(percent float64) Depth {
+	depth := Depth{Percent: percent}
+	mid, ok := b.Mid()
+	if !ok {
+		return depth
+	}
+	for _, level := range b.Bids {
+		if level.Price < mid*(100-percent)/100 {
+			break
+		}
+		depth.Bid += level.Price * level.Quantity
+	}
+	for _, level := range b.Asks {
+		if level.Price > mid*(100+percent)/100 {
+			break
+		}
+		depth.Ask += level.Price * level.Quantity
+	}
+	return depth
+}
+
+// Slippage returns the estimated fills of a buy and a sell market order of notional in
+// the quote asset, walking the asks and bids from the best level.
+func (b OrderBook) Slippage(notional float64) Slippage {
+	slippage := Slippage{Notional: notional}
+	mid, ok := b.Mid()
+	if !ok || notional <= 0 {
+		return slippage
+	}
+	if price, ok := fillPrice(b.Asks, notional); ok {
+		bps := (price - mid) / mid * 10000
+		slippage.BuyPrice, slippage.BuyBps = &price, &bps
+	}
+	if price, ok := fillPrice(b.Bids, notional); ok {
+		bps := (mid - price) / mid * 10000
+		slippage.SellPrice, slippage.SellBps = &price, &bps
+	}
+	return slippage
+}
+
+// Analyze returns the liquidity metrics of the book: the spread, the depth within every
+// DepthPercents distance and, for a positive notional, the slippage of an order of that size.
+func (b OrderBook) Analyze(notional float64) Liquidity {
+	var liquidity Liquidity
+	mid, ok := b.Mid()
+	if !ok {
+		return liquidity
+	}
+	liquidity.Mid = mid
+	liquidity.SpreadBps = (b.Asks[0].Price - b.Bids[0].Price) / mid * 10000
+	for _, percent := range DepthPercents {
+		liquidity.Depth = append(liquidity.Depth, b.Depth(percent))
+	}
+	if notional > 0 {
+		slippage := b.Slippage(notional)
+		liquidity.Slippage = &slippage
+	}
+	return liquidity
+}
+
+// Fills reports whether a buy and a sell of notional in the quote asset both fill within
+// maxBps basis points of the mid price.
+func (b OrderBook) Fills(notional, maxBps float64) bool {
+	slippage := b.Slippage(notional)
+	return slippage.BuyBps != nil && *slippage.BuyBps <= maxBps &&
+		slippage.SellBps != nil && *slippage.SellBps <= maxBps
+}
+
+// fillPrice returns the average price of filling notional in the quote asset from the
+// levels, best first. It reports false when the levels hold less than notional.
+func fillPrice(levels []Level, notional float64) (float64, bool) {
+	remaining, quantity := notional, 0.0
+	for _, level := range levels {
+		if level.Price <= 0 {
+			continue
+		}
+		take := min(remaining, level.Price*level.Quantity)
+		quantity += take / level.Price
+		remaining -= take
+		if remaining <= 0 {
+			return notional / quantity, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,109 @@
+package common
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func testOrderBook() OrderBook {
+	return OrderBook{
+		Bids: []Level{{Price: 99.9, Quantity: 10}, {Price: 99.5, Quantity: 20}, {Price: 98, Quantity: 100}},
+		Asks: []Level{{Price: 100.1, Quantity: 10}, {Price: 100.5, Quantity: 20}, {Price: 102, Quantity: 100}},
+	}
+}
+
+func TestOrderBookAnalyze(t *testing.T) {
+	liquidity := testOrderBook().Analyze(0)
+	if liquidity.Mid != 100 {
+		t.Errorf("Expected mid 100, but got %v", liquidity.Mid)
+	}
+	if math.Abs(liquidity.SpreadBps-20) > 1e-9 {
+		t.Errorf("Expected a spread of 20 bps, but got %v", liquidity.SpreadBps)
+	}
+	if liquidity.Slippage != nil {
+		t.Errorf("Expected no slippage without a notional, but got %+v", liquidity.Slippage)
+	}
+
+	tests := []struct {
+		percent float64
+		bid     float64
+		ask     float64
+	}{
+		{0.5, 99.9*10 + 99.5*20, 100.1*10 + 100.5*20},
+		{1, 99.9*10 + 99.5*20, 100.1*10 + 100.5*20},
+		{2, 99.9*10 + 99.5*20 + 98*100, 100.1*10 + 100.5*20 + 102*100},
+	}
+	for i, test := range tests {
+		depth := liquidity.Depth[i]
+		if depth.Percent != test.percent || math.Abs(depth.Bid-test.bid) > 1e-9 || math.Abs(depth.Ask-test.ask) > 1e-9 {
+			t.Errorf("Expected depth %v/%v within %v%%, but got %+v", test.bid, test.ask, test.percent, depth)
+		}
+	}
+
+	if liquidity := (OrderBook{}).Analyze(1000); liquidity.Mid != 0 || liquidity.Depth != nil {
+		t.Errorf("Expected no metrics for an empty book, but got %+v", liquidity)
+	}
+}
+
+func TestOrderBookSlippage(t *testing.T) {
+	book := testOrderBook()
+
+	// 1001 fills the best ask exactly; 3011 also takes the whole second level.
+	slippage := book.Slippage(1001)
+	if slippage.BuyPrice == nil || math.Abs(*slippage.BuyPrice-100.1) > 1e-9 || math.Abs(*slippage.BuyBps-10) > 1e-9 {
+		t.Errorf("Expected a buy at 100.1 (10 bps), but got %+v", slippage)
+	}
+	slippage = book.Slippage(3011)
+	if slippage.BuyPrice == nil || math.Abs(*slippage.BuyPrice-3011.0/30) > 1e-9 {
+		t.Errorf("Expected a buy at %v, but got %+v", 3011.0/30, slippage)
+	}
+
+	slippage = book.Slippage(20000)
+	if slippage.BuyPrice != nil || slippage.SellPrice != nil {
+		t.Errorf("Expected no fills beyond the book, but got %+v", slippage)
+	}
+
+	if !book.Fills(1000, 11) {
+		t.Errorf("Expected 1000 to fill within 11 bps")
+	}
+	if book.Fills(3000, 11) {
+		t.Errorf("Expected 3000 not to fill within 11 bps")
+	}
+}
+
+func TestChainChecks(t *testing.T) {
+	tickers := []Ticker{{Symbol: "BTCUSDT"}, {Symbol: "ETHUSDT"}, {Symbol: "SOLUSDT"}}
+	var checked []string
+	first := func(tickers []Ticker) []bool {
+		kept := make([]bool, len(tickers))
+		for i, t := range tickers {
+			kept[i] = t.Symbol != "ETHUSDT"
+		}
+		return kept
+	}
+	failure := errors.New("order book unavailable")
+	second, failed := CheckEach(2, func(ticker Ticker) (bool, error) {
+		checked = append(checked, ticker.Symbol)
+		if ticker.Symbol == "SOLUSDT" {
+			return true, failure
+		}
+		return true, nil
+	})
+
+	kept := ChainChecks(first, second)(tickers)
+	if !kept[0] || kept[1] || kept[2] {
+		t.Errorf("Expected only BTCUSDT to be kept, but got %v", kept)
+	}
+	if len(checked) != 2 {
+		t.Errorf("Expected the second check to see 2 tickers, but got %v", checked)
+	}
+	var partial *PartialError
+	if !errors.As(failed(), &partial) || partial.Errors["SOLUSDT"] != failure.Error() {
+		t.Errorf("Expected the check error of SOLUSDT, but got %v", failed())
+	}
+
+	if kept := ChainChecks(nil, first)(tickers); kept[1] {
+		t.Errorf("Expected a nil check to defer to the other, but got %v", kept)
+	}
+}
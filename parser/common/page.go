@@ -0,0 +1,197 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownField is returned when a page query sorts by or selects a field the rows do not have.
+var ErrUnknownField = errors.New("unknown field")
+
+// ErrInvalidCursor is returned when a page cursor was not issued by Paginate.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// DefaultPageLimit is the page size of paged lists that do not set a limit.
+const DefaultPageLimit = 100
+
+// Page orders.
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// PageQuery selects, orders and projects one page of a list.
+//
+// Sort names the JSON field to order by, largest first unless Order is OrderAsc.
+// Without Sort the list keeps its own order and OrderAsc reverses it. Limit 0
+// returns every row after Offset. Fields lists the JSON fields kept in every row;
+// when it is empty rows are returned unchanged.
+type PageQuery struct {
+	Sort   string
+	Order  string
+	Offset int
+	Limit  int
+	Fields []string
+}
+
+// Page is one page of a list. Total counts the rows of the whole list and
+// NextCursor, set while rows remain, requests the following page. Errors lists the
+// symbols left out of the list because they could not be fetched; see PartialError.
+type Page struct {
+	Total      int               `json:"total"`
+	Offset     int               `json:"offset"`
+	Limit      int               `json:"limit"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	Data       []interface{}     `json:"data"`
+	Errors     map[string]string `json:"errors,omitempty"`
+}
+
+// IsValidOrder reports whether order is a supported page order; "" selects the default.
+func IsValidOrder(order string) bool {
+	return order == "" || order == OrderAsc || order == OrderDesc
+}
+
+// Paginate returns the page of list selected by the query. The list may be any slice
+// of JSON objects, such as the native ticker payload of an exchange; rows are sorted
+// and projected by their JSON field names. Numeric strings, as most exchanges report
+// prices, sort as numbers and rows missing the sort field are listed last.
+func Paginate(list interface{}, query PageQuery) (Page, error) {
+	raw, err := json.Marshal(list)
+	if err != nil {
+		return Page{}, err
+	}
+	var rows []json.RawMessage
+	if err := json.Unmarshal(raw, &rows); err != nil {
+		return Page{}, err
+	}
+
+	fields := make([]map[string]json.RawMessage, len(rows))
+	known := make(map[string]bool)
+	for i, row := range rows {
+		if err := json.Unmarshal(row, &fields[i]); err != nil {
+			return Page{}, err
+		}
+		for name := range fields[i] {
+			known[name] = true
+		}
+	}
+	if len(rows) > 0 {
+		for _, name := range append([]string{query.Sort}, query.Fields...) {
+			if name != "" && !known[name] {
+				return Page{}, fmt.Errorf("%w: %s", ErrUnknownField, name)
+			}
+		}
+	}
+
+	order := make([]int, len(rows))
+	for i := range order {
+		order[i] = i
+	}
+	switch {
+	case query.Sort != "":
+		values := make([]sortKey, len(rows))
+		for i := range rows {
+			values[i] = newSortKey(fields[i][query.Sort])
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return values[order[i]].less(values[order[j]], query.Order == OrderAsc)
+		})
+	case query.Order == OrderAsc:
+		for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+			order[i], order[j] = order[j], order[i]
+		}
+	}
+
+	page := Page{Total: len(rows), Offset: query.Offset, Limit: query.Limit, Data: make([]interface{}, 0)}
+	start := min(max(query.Offset, 0), len(order))
+	end := len(order)
+	if query.Limit > 0 && start+query.Limit < end {
+		end = start + query.Limit
+		page.NextCursor = EncodeCursor(end)
+	}
+	for _, i := range order[start:end] {
+		if len(query.Fields) == 0 {
+			page.Data = append(page.Data, rows[i])
+			continue
+		}
+		projected := make(map[string]json.RawMessage, len(query.Fields))
+		for _, name := range query.Fields {
+			if value, ok := fields[i][name]; ok {
+				projected[name] = value
+			}
+		}
+		page.Data = append(page.Data, projected)
+	}
+	return page, nil
+}
+
+// EncodeCursor returns the opaque cursor of the page starting at offset.
+func EncodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+// DecodeCursor returns the offset of a cursor issued by Paginate.
+func DecodeCursor(cursor string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(raw), "offset:"))
+	if err != nil || offset < 0 || !strings.HasPrefix(string(raw), "offset:") {
+		return 0, ErrInvalidCursor
+	}
+	return offset, nil
+}
+
+// sortKey is the comparable value of a row field: a number when the field is numeric
+// or a numeric string, text otherwise.
+type sortKey struct {
+	missing bool
+	numeric bool
+	number  float64
+	text    string
+}
+
+func newSortKey(raw json.RawMessage) sortKey {
+	var value interface{}
+	if len(raw) == 0 || json.Unmarshal(raw, &value) != nil || value == nil {
+		return sortKey{missing: true}
+	}
+	switch v := value.(type) {
+	case float64:
+		return sortKey{numeric: true, number: v}
+	case string:
+		if number, err := strconv.ParseFloat(v, 64); err == nil {
+			return sortKey{numeric: true, number: number}
+		}
+		return sortKey{text: v}
+	default:
+		return sortKey{text: string(raw)}
+	}
+}
+
+// less reports whether k is listed before other. Missing values are listed last and
+// numbers before text, whatever the order.
+func (k sortKey) less(other sortKey, ascending bool) bool {
+	if k.missing || other.missing {
+		return !k.missing && other.missing
+	}
+	if k.numeric != other.numeric {
+		return k.numeric
+	}
+	if k.numeric {
+		if ascending {
+			return k.number < other.number
+		}
+		return k.number > other.number
+	}
+	if ascending {
+		return k.text < other.text
+	}
+	return k.text > other.text
+}
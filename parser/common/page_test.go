@@ -0,0 +1,86 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type pageRow struct {
+	Symbol string `json:"symbol"`
+	Change string `json:"priceChangePercent"`
+	Volume string `json:"volume"`
+}
+
+func TestPaginate(t *testing.T) {
+	rows := []pageRow{
+		{"BTCUSDT", "2.5", "100"},
+		{"ETHUSDT", "-1", "900"},
+		{"SOLUSDT", "10", "50"},
+		{"XRPUSDT", "0.5", "400"},
+	}
+
+	tests := []struct {
+		name       string
+		query      PageQuery
+		want       []string
+		nextCursor bool
+	}{
+		{"all", PageQuery{}, []string{"BTCUSDT", "ETHUSDT", "SOLUSDT", "XRPUSDT"}, false},
+		{"sort desc", PageQuery{Sort: "priceChangePercent"}, []string{"SOLUSDT", "BTCUSDT", "XRPUSDT", "ETHUSDT"}, false},
+		{"sort asc", PageQuery{Sort: "volume", Order: OrderAsc}, []string{"SOLUSDT", "BTCUSDT", "XRPUSDT", "ETHUSDT"}, false},
+		{"reverse", PageQuery{Order: OrderAsc}, []string{"XRPUSDT", "SOLUSDT", "ETHUSDT", "BTCUSDT"}, false},
+		{"page", PageQuery{Sort: "priceChangePercent", Offset: 1, Limit: 2}, []string{"BTCUSDT", "XRPUSDT"}, true},
+		{"last page", PageQuery{Offset: 3, Limit: 2}, []string{"XRPUSDT"}, false},
+		{"past end", PageQuery{Offset: 10, Limit: 2}, []string{}, false},
+	}
+
+	for _, tt := range tests {
+		page, err := Paginate(rows, tt.query)
+		if err != nil {
+			t.Fatalf("%s: expected no error, but got %v", tt.name, err)
+		}
+		if page.Total != len(rows) {
+			t.Errorf("%s: expected total %d, but got %d", tt.name, len(rows), page.Total)
+		}
+		if (page.NextCursor != "") != tt.nextCursor {
+			t.Errorf("%s: expected next cursor %v, but got %q", tt.name, tt.nextCursor, page.NextCursor)
+		}
+		if len(page.Data) != len(tt.want) {
+			t.Fatalf("%s: expected %d rows, but got %d", tt.name, len(tt.want), len(page.Data))
+		}
+		for i, symbol := range tt.want {
+			var row pageRow
+			raw, _ := json.Marshal(page.Data[i])
+			_ = json.Unmarshal(raw, &row)
+			if row.Symbol != symbol {
+				t.Errorf("%s: expected %s at position %d, but got %s", tt.name, symbol, i, row.Symbol)
+			}
+		}
+	}
+}
+
+func TestPaginateFields(t *testing.T) {
+	page, err := Paginate([]pageRow{{"BTCUSDT", "2.5", "100"}}, PageQuery{Fields: []string{"symbol", "volume"}})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	raw, _ := json.Marshal(page.Data[0])
+	if string(raw) != `{"symbol":"BTCUSDT","volume":"100"}` {
+		t.Errorf("Expected projected row, but got %s", raw)
+	}
+
+	if _, err := Paginate([]pageRow{{"BTCUSDT", "2.5", "100"}}, PageQuery{Sort: "price"}); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("Expected ErrUnknownField, but got %v", err)
+	}
+}
+
+func TestCursor(t *testing.T) {
+	offset, err := DecodeCursor(EncodeCursor(200))
+	if err != nil || offset != 200 {
+		t.Errorf("Expected offset 200, but got %d (%v)", offset, err)
+	}
+	if _, err := DecodeCursor("not-a-cursor"); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Expected ErrInvalidCursor, but got %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PartialError is returned alongside the fetched rows when some symbols could not be
+// fetched; the rows leave them out. Errors is keyed by symbol.
+type PartialError struct {
+	Errors map[string]string
+}
+
+// MergePartial merges the symbol errors of the *PartialError values among errs into one
+// *PartialError, or returns nil when there are none. Any other error is returned first,
+// since it is not partial.
+func MergePartial(errs ...error) error {
+	var merged map[string]string
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		var partial *PartialError
+		if !errors.As(err, &partial) {
+			return err
+		}
+		if merged == nil {
+			merged = make(map[string]string)
+		}
+		for symbol, message := range partial.Errors {
+			merged[symbol] = message
+		}
+	}
+	if merged == nil {
+		return nil
+	}
+	return &PartialError{Errors: merged}
+}
+
+func (e *PartialError) Error() string {
+	symbols := make([]string, 0, len(e.Errors))
+	for symbol := range e.Errors {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return fmt.Sprintf("fetching failed for %d symbols: %s", len(symbols), strings.Join(symbols, ", "))
+}
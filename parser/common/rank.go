@@ -0,0 +1,250 @@
+package common
+
+import (
+	"sort"
+	"strings"
+)
+
+// Ranking selects the tickers a ranking keeps and the order they are listed in.
+type Ranking string
+
+const (
+	// RankingGainers lists tickers with a positive percent change, largest gain first.
+	RankingGainers Ranking = "gainers"
+	// RankingLosers lists tickers with a negative percent change, largest loss first.
+	RankingLosers Ranking = "losers"
+	// RankingVolume lists traded tickers by quote volume, largest first.
+	RankingVolume Ranking = "volume"
+	// RankingVolatile lists tickers by their 24-hour (high-low)/open range, widest first.
+	RankingVolatile Ranking = "volatile"
+)
+
+// Rank returns the tickers kept by the ranking that meet the thresholds and match the
+// ending (quote assets), include, exclude, category, derivative and expression filters
+// and the check of the query, in ranking order and truncated to the query limit. A
+// query sort key overrides the ranking order with a descending sort by that field.
+//
+// Volume is compared in USD: quote volumes in other assets are converted at their USD
+// price from the same tickers, falling back to the raw quote volume when the quote asset
+// has no USD price.
+func Rank(tickers []Ticker, ranking Ranking, query GainersQuery) []Ticker {
+	rates := USDRates(tickers)
+	bases := baseAssets(tickers)
+	value := func(t Ticker) float64 {
+		switch ranking {
+		case RankingLosers:
+			return -t.ChangePercent
+		case RankingVolume:
+			return usdVolume(t, rates)
+		case RankingVolatile:
+			return RangePercent(t)
+		default:
+			return t.ChangePercent
+		}
+	}
+
+	matchSymbol := symbolFilter(query, bases)
+	ranked := make([]Ticker, 0)
+	for _, t := range query.Thresholds.Filter(tickers) {
+		if value(t) <= 0 {
+			continue
+		}
+		t, ok := matchSymbol(t)
+		if !ok {
+			continue
+		}
+		if !matchesDerivative(t, query) {
+			continue
+		}
+		if query.Filter != nil && !query.Filter.Match(tickerLookup(t, rates, bases)) {
+			continue
+		}
+		ranked = append(ranked, t)
+	}
+
+	if query.SortBy != "" && query.SortBy != SortByChange {
+		value = func(t Ticker) float64 { return sortValue(t, query.SortBy) }
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return value(ranked[i]) > value(ranked[j])
+	})
+
+	if query.Check != nil {
+		if query.CheckLimit > 0 && query.CheckLimit < len(ranked) {
+			ranked = ranked[:query.CheckLimit]
+		}
+		return check(ranked, query.Limit, query.Check)
+	}
+	if query.Limit > 0 && query.Limit < len(ranked) {
+		ranked = ranked[:query.Limit]
+	}
+	return ranked
+}
+
+// check returns the ranked tickers kept by the check, in ranking order and up to the
+// limit. Tickers are checked in batches of the number still missing, so that no more
+// tickers are checked than needed.
+func check(ranked []Ticker, limit int, keep func([]Ticker) []bool) []Ticker {
+	kept := make([]Ticker, 0)
+	for start := 0; start < len(ranked) && (limit <= 0 || len(kept) < limit); {
+		end := len(ranked)
+		if limit > 0 {
+			end = min(end, start+limit-len(kept))
+		}
+		batch := ranked[start:end]
+		for i, ok := range keep(batch) {
+			if ok {
+				kept = append(kept, batch[i])
+			}
+		}
+		start = end
+	}
+	return kept
+}
+
+// Gainers returns the gainers ranking of the tickers; see Rank.
+func Gainers(tickers []Ticker, query GainersQuery) []Ticker {
+	return Rank(tickers, RankingGainers, query)
+}
+
+// IsValidRanking reports whether ranking is a supported ranking.
+func IsValidRanking(ranking Ranking) bool {
+	switch ranking {
+	case RankingGainers, RankingLosers, RankingVolume, RankingVolatile:
+		return true
+	default:
+		return false
+	}
+}
+
+// RangePercent returns the 24-hour high-low range of the ticker as a percentage of its
+// open price, or zero when the open price is unknown.
+func RangePercent(t Ticker) float64 {
+	if t.OpenPrice <= 0 || t.HighPrice < t.LowPrice {
+		return 0
+	}
+	return (t.HighPrice - t.LowPrice) / t.OpenPrice * 100
+}
+
+// usdVolume returns the quote volume of the ticker in USD, or the raw quote volume when
+// its quote asset has no USD price.
+func usdVolume(t Ticker, rates map[string]float64) float64 {
+	if rate, ok := rates[t.QuoteAsset]; ok {
+		return t.QuoteVolume * rate
+	}
+	return t.QuoteVolume
+}
+
+// symbolFilter returns a function reporting whether a ticker matches the ending, include,
+// exclude and category filters of the query, classifying tickers against the given base
+// assets. The returned ticker has its base and quote set by the matched quote; see matchQuote.
+func symbolFilter(query GainersQuery, bases map[string]bool) func(Ticker) (Ticker, bool) {
+	quotes := SplitList(query.EndingFilter)
+	include, _ := NewMatcher(query.IncludeMode, query.IncludeFilter, DefaultIncludeMode)
+	exclude, _ := NewMatcher(query.ExcludeMode, query.ExcludeFilter, DefaultExcludeMode)
+
+	return func(t Ticker) (Ticker, bool) {
+		t, ok := matchQuote(t, quotes)
+		if !ok {
+			return t, false
+		}
+		if !include.Empty() && !include.Match(t) {
+			return t, false
+		}
+		if exclude.Match(t) {
+			return t, false
+		}
+		return t, !excludesCategory(query, categories(t, bases))
+	}
+}
+
+// excludesCategory reports whether the query drops a ticker of the given categories.
+func excludesCategory(query GainersQuery, categories []Category) bool {
+	return query.ExcludeLeveraged && HasCategory(categories, CategoryLeveraged) ||
+		query.ExcludeStablePairs && HasCategory(categories, CategoryStablePair) ||
+		query.ExcludeFiatPairs && HasCategory(categories, CategoryFiatPair)
+}
+
+// matchesDerivative reports whether the ticker is within the funding rate and open
+// interest bounds of the query. Funding rate bounds only keep tickers with a next
+// funding time, i.e. perpetual contracts.
+func matchesDerivative(t Ticker, query GainersQuery) bool {
+	if (query.MinFundingRate != nil || query.MaxFundingRate != nil) && t.NextFundingTime == nil {
+		return false
+	}
+	if query.MinFundingRate != nil && t.FundingRate < *query.MinFundingRate {
+		return false
+	}
+	if query.MaxFundingRate != nil && t.FundingRate > *query.MaxFundingRate {
+		return false
+	}
+	return t.OpenInterestValue >= query.MinOpenInterestValue
+}
+
+// sortValue returns the value of the ticker for the given sort key.
+func sortValue(t Ticker, sortBy string) float64 {
+	switch sortBy {
+	case SortByFundingRate:
+		return t.FundingRate
+	case SortByOpenInterest:
+		return t.OpenInterest
+	case SortByOpenInterestValue:
+		return t.OpenInterestValue
+	default:
+		return t.ChangePercent
+	}
+}
+
+// matchQuote reports whether the ticker is quoted in one of the given assets, falling
+// back to the symbol suffix when the quote asset is unknown; the matched suffix then sets
+// the base and quote assets of the returned ticker so that it formats as "BASE/QUOTE".
+// An empty list matches every ticker.
+func matchQuote(t Ticker, quotes []string) (Ticker, bool) {
+	if len(quotes) == 0 {
+		return t, true
+	}
+	for _, quote := range quotes {
+		if t.QuoteAsset != "" {
+			if strings.EqualFold(t.QuoteAsset, quote) {
+				return t, true
+			}
+			continue
+		}
+		if len(t.Symbol) > len(quote) && strings.HasSuffix(strings.ToUpper(t.Symbol), quote) {
+			t.BaseAsset = t.Symbol[:len(t.Symbol)-len(quote)]
+			t.QuoteAsset = quote
+			return t, true
+		}
+	}
+	return t, false
+}
+
+// Pick returns the native items matching the ranked tickers, in ticker order. It lets an
+// exchange client rank its normalized tickers while still returning its native payload.
+func Pick[T any](items []T, symbol func(T) string, ranked []Ticker) []T {
+	bySymbol := make(map[string]T, len(items))
+	for _, item := range items {
+		bySymbol[symbol(item)] = item
+	}
+
+	picked := make([]T, 0, len(ranked))
+	for _, t := range ranked {
+		if item, ok := bySymbol[t.Symbol]; ok {
+			picked = append(picked, item)
+		}
+	}
+	return picked
+}
+
+// PairList formats the tickers as a pair list of "BASE/QUOTE" pairs. Tickers whose
+// quote asset is unknown are listed by their raw symbol.
+func PairList(tickers []Ticker) PairListResponse {
+	pairs := make([]string, 0, len(tickers))
+	for _, t := range tickers {
+		pairs = append(pairs, pairKey(t))
+	}
+	return PairListResponse{
+		Pairs:         pairs,
+		RefreshPeriod: DefaultRefreshPeriod,
+	}
+}
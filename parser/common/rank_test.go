@@ -0,0 +1,136 @@
+package common
+
+import "testing"
+
+func TestRank(t *testing.T) {
+	tickers := []Ticker{
+		{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", LastPrice: 50000, OpenPrice: 49000, HighPrice: 51000, LowPrice: 48000, ChangePercent: 2, QuoteVolume: 5000000},
+		{Symbol: "ETHBTC", BaseAsset: "ETH", QuoteAsset: "BTC", LastPrice: 0.05, OpenPrice: 0.05, HighPrice: 0.06, LowPrice: 0.04, ChangePercent: -1, QuoteVolume: 200},
+		{Symbol: "SOLUSDT", BaseAsset: "SOL", QuoteAsset: "USDT", LastPrice: 90, OpenPrice: 100, HighPrice: 101, LowPrice: 89, ChangePercent: -10, QuoteVolume: 3000000},
+		{Symbol: "FLATUSDT", BaseAsset: "FLAT", QuoteAsset: "USDT", LastPrice: 1, OpenPrice: 1, HighPrice: 1, LowPrice: 1},
+	}
+
+	tests := []struct {
+		ranking Ranking
+		query   GainersQuery
+		want    []string
+	}{
+		{RankingGainers, GainersQuery{}, []string{"BTCUSDT"}},
+		{RankingLosers, GainersQuery{}, []string{"SOLUSDT", "ETHBTC"}},
+		{RankingLosers, GainersQuery{EndingFilter: "USDT"}, []string{"SOLUSDT"}},
+		{RankingVolume, GainersQuery{}, []string{"ETHBTC", "BTCUSDT", "SOLUSDT"}},
+		{RankingVolume, GainersQuery{Limit: 1}, []string{"ETHBTC"}},
+		{RankingVolatile, GainersQuery{}, []string{"ETHBTC", "SOLUSDT", "BTCUSDT"}},
+	}
+
+	for _, tt := range tests {
+		got := Rank(tickers, tt.ranking, tt.query)
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: expected %d tickers, but got %d", tt.ranking, len(tt.want), len(got))
+		}
+		for i, symbol := range tt.want {
+			if got[i].Symbol != symbol {
+				t.Errorf("%s: expected %s at position %d, but got %s", tt.ranking, symbol, i, got[i].Symbol)
+			}
+		}
+	}
+}
+
+func TestRankFilter(t *testing.T) {
+	tickers := []Ticker{
+		{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", LastPrice: 50000, ChangePercent: 6, QuoteVolume: 5000000},
+		{Symbol: "BNBUSDT", BaseAsset: "BNB", QuoteAsset: "USDT", LastPrice: 300, ChangePercent: 9, QuoteVolume: 2000000},
+		{Symbol: "ETHBTC", BaseAsset: "ETH", QuoteAsset: "BTC", LastPrice: 0.05, ChangePercent: 7, QuoteVolume: 100},
+		{Symbol: "DOGEUSDT", BaseAsset: "DOGE", QuoteAsset: "USDT", LastPrice: 0.1, ChangePercent: 12, QuoteVolume: 500000},
+	}
+
+	program, err := ParseFilter(`quote == "USDT" && change_pct > 5 && quote_volume > 1e6 && !(base in ["BNB","FDUSD"])`)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	got := Rank(tickers, RankingGainers, GainersQuery{Filter: program})
+	if len(got) != 1 || got[0].Symbol != "BTCUSDT" {
+		t.Errorf("Expected only BTCUSDT, but got %+v", got)
+	}
+
+	program, _ = ParseFilter(`usd_volume > 4e6`)
+	got = Rank(tickers, RankingGainers, GainersQuery{Filter: program})
+	if len(got) != 2 || got[0].Symbol != "ETHBTC" || got[1].Symbol != "BTCUSDT" {
+		t.Errorf("Expected ETHBTC and BTCUSDT, but got %+v", got)
+	}
+}
+
+func TestRankLists(t *testing.T) {
+	tickers := []Ticker{
+		{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", ChangePercent: 6},
+		{Symbol: "BTCUSDC", BaseAsset: "BTC", QuoteAsset: "USDC", ChangePercent: 5},
+		{Symbol: "ETHFDUSD", ChangePercent: 4},
+		{Symbol: "BNBUSDT", BaseAsset: "BNB", QuoteAsset: "USDT", ChangePercent: 3},
+		{Symbol: "WBNBUSDT", BaseAsset: "WBNB", QuoteAsset: "USDT", ChangePercent: 2},
+		{Symbol: "SOLBTC", BaseAsset: "SOL", QuoteAsset: "BTC", ChangePercent: 1},
+	}
+
+	tests := []struct {
+		name  string
+		query GainersQuery
+		want  []string
+	}{
+		{"quotes", GainersQuery{EndingFilter: "USDT,USDC,FDUSD"}, []string{"BTC/USDT", "BTC/USDC", "ETH/FDUSD", "BNB/USDT", "WBNB/USDT"}},
+		{"exclude substring", GainersQuery{EndingFilter: "USDT", ExcludeFilter: "BNB"}, []string{"BTC/USDT"}},
+		{"exclude base", GainersQuery{EndingFilter: "USDT", ExcludeFilter: "BNB,FDUSD", ExcludeMode: MatchBase}, []string{"BTC/USDT", "WBNB/USDT"}},
+		{"include base", GainersQuery{IncludeFilter: "BTC,SOL"}, []string{"BTC/USDT", "BTC/USDC", "SOL/BTC"}},
+		{"include regex", GainersQuery{IncludeFilter: "^W?BNB", IncludeMode: MatchRegex}, []string{"BNB/USDT", "WBNB/USDT"}},
+	}
+
+	for _, tt := range tests {
+		got := PairList(Rank(tickers, RankingGainers, tt.query)).Pairs
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: expected %v, but got %v", tt.name, tt.want, got)
+		}
+		for i, pair := range tt.want {
+			if got[i] != pair {
+				t.Errorf("%s: expected %s at position %d, but got %s", tt.name, pair, i, got[i])
+			}
+		}
+	}
+}
+
+func TestRankCheck(t *testing.T) {
+	tickers := []Ticker{
+		{Symbol: "AUSDT", ChangePercent: 5},
+		{Symbol: "BUSDT", ChangePercent: 4},
+		{Symbol: "CUSDT", ChangePercent: 3},
+		{Symbol: "DUSDT", ChangePercent: 2},
+		{Symbol: "EUSDT", ChangePercent: 1},
+	}
+	var checked []string
+	query := GainersQuery{
+		Limit: 2,
+		Check: func(batch []Ticker) []bool {
+			keep := make([]bool, len(batch))
+			for i, t := range batch {
+				checked = append(checked, t.Symbol)
+				keep[i] = t.Symbol != "BUSDT"
+			}
+			return keep
+		},
+	}
+
+	ranked := Rank(tickers, RankingGainers, query)
+	if len(ranked) != 2 || ranked[0].Symbol != "AUSDT" || ranked[1].Symbol != "CUSDT" {
+		t.Errorf("Expected AUSDT and CUSDT, but got %+v", ranked)
+	}
+	if len(checked) != 3 {
+		t.Errorf("Expected only the 3 tickers needed to be checked, but got %v", checked)
+	}
+
+	checked = nil
+	query.Limit, query.CheckLimit = 0, 2
+	ranked = Rank(tickers, RankingGainers, query)
+	if len(ranked) != 1 || ranked[0].Symbol != "AUSDT" {
+		t.Errorf("Expected AUSDT only, but got %+v", ranked)
+	}
+	if len(checked) != 2 {
+		t.Errorf("Expected the check limit of 2 tickers to be checked, but got %v", checked)
+	}
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"math"
+	"sort"
+)
+
+// Quote is the last price and top of book of a symbol on one exchange.
+type Quote struct {
+	Exchange  string  `json:"exchange"`
+	Symbol    string  `json:"symbol"`
+	LastPrice float64 `json:"last_price"`
+	BidPrice  float64 `json:"bid_price"`
+	AskPrice  float64 `json:"ask_price"`
+}
+
+// Spread compares a trading pair listed on two exchanges.
+//
+// SpreadPercent is the difference of the second last price relative to the
+// first one. ArbitragePercent is the best return of buying at the ask on one
+// exchange and selling at the bid on the other, before fees; BuyExchange and
+// SellExchange name the venues of that trade.
+type Spread struct {
+	Pair             string  `json:"pair"`
+	First            Quote   `json:"first"`
+	Second           Quote   `json:"second"`
+	SpreadPercent    float64 `json:"spread_percent"`
+	ArbitragePercent float64 `json:"arbitrage_percent"`
+	BuyExchange      string  `json:"buy_exchange,omitempty"`
+	SellExchange     string  `json:"sell_exchange,omitempty"`
+}
+
+// Spreads matches the tickers of two exchanges by base and quote asset and
+// returns the price spread of every pair listed on both, sorted by the
+// largest absolute spread first.
+func Spreads(first, second []Ticker) []Spread {
+	seconds := make(map[string]Ticker, len(second))
+	for _, t := range second {
+		seconds[pairKey(t)] = t
+	}
+
+	spreads := make([]Spread, 0)
+	for _, a := range first {
+		key := pairKey(a)
+		b, ok := seconds[key]
+		if !ok || a.LastPrice <= 0 || b.LastPrice <= 0 {
+			continue
+		}
+
+		spread := Spread{
+			Pair:          key,
+			First:         quoteOf(a),
+			Second:        quoteOf(b),
+			SpreadPercent: ChangePercent(a.LastPrice, b.LastPrice),
+		}
+		spread.ArbitragePercent, spread.BuyExchange, spread.SellExchange = arbitrage(a, b)
+		spreads = append(spreads, spread)
+	}
+
+	sort.SliceStable(spreads, func(i, j int) bool {
+		return math.Abs(spreads[i].SpreadPercent) > math.Abs(spreads[j].SpreadPercent)
+	})
+	return spreads
+}
+
+// arbitrage returns the best buy-at-ask, sell-at-bid return between the two tickers
+// and the exchanges to buy and sell on. The return is zero when top of book is missing.
+func arbitrage(a, b Ticker) (float64, string, string) {
+	if a.AskPrice <= 0 || a.BidPrice <= 0 || b.AskPrice <= 0 || b.BidPrice <= 0 {
+		return 0, "", ""
+	}
+
+	buyA := ChangePercent(a.AskPrice, b.BidPrice)
+	buyB := ChangePercent(b.AskPrice, a.BidPrice)
+	if buyA >= buyB {
+		return buyA, a.Exchange, b.Exchange
+	}
+	return buyB, b.Exchange, a.Exchange
+}
+
+// pairKey identifies a trading pair across exchanges as "BASE/QUOTE",
+// falling back to the raw symbol when the quote asset is unknown.
+func pairKey(t Ticker) string {
+	if t.BaseAsset == "" || t.QuoteAsset == "" {
+		return t.Symbol
+	}
+	return t.BaseAsset + "/" + t.QuoteAsset
+}
+
+func quoteOf(t Ticker) Quote {
+	return Quote{
+		Exchange:  t.Exchange,
+		Symbol:    t.Symbol,
+		LastPrice: t.LastPrice,
+		BidPrice:  t.BidPrice,
+		AskPrice:  t.AskPrice,
+	}
+}
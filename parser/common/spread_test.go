@@ -0,0 +1,29 @@
+package common
+
+import "testing"
+
+func TestSpreads(t *testing.T) {
+	first := []Ticker{
+		{Exchange: "binance", Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", LastPrice: 100, BidPrice: 99, AskPrice: 100},
+		{Exchange: "binance", Symbol: "ETHUSDT", BaseAsset: "ETH", QuoteAsset: "USDT", LastPrice: 10, BidPrice: 9.9, AskPrice: 10},
+		{Exchange: "binance", Symbol: "BNBUSDT", BaseAsset: "BNB", QuoteAsset: "USDT", LastPrice: 5},
+	}
+	second := []Ticker{
+		{Exchange: "bybit", Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", LastPrice: 101, BidPrice: 101, AskPrice: 102},
+		{Exchange: "bybit", Symbol: "ETHUSDT", BaseAsset: "ETH", QuoteAsset: "USDT", LastPrice: 11, BidPrice: 10.5, AskPrice: 11},
+	}
+
+	spreads := Spreads(first, second)
+	if len(spreads) != 2 {
+		t.Fatalf("Expected 2 common pairs, but got %d", len(spreads))
+	}
+	if spreads[0].Pair != "ETH/USDT" {
+		t.Errorf("Expected the largest gap first, but got %s", spreads[0].Pair)
+	}
+	if spreads[0].BuyExchange != "binance" || spreads[0].SellExchange != "bybit" {
+		t.Errorf("Expected to buy on binance and sell on bybit, but got %s and %s", spreads[0].BuyExchange, spreads[0].SellExchange)
+	}
+	if spreads[0].ArbitragePercent != 5 {
+		t.Errorf("Expected an arbitrage of 5%%, but got %v", spreads[0].ArbitragePercent)
+	}
+}
@@ -0,0 +1,111 @@
+package common
+
+import "github.com/cploutarchou/CryptoGainerAPI-Client/parser/filter"
+
+// DefaultRefreshPeriod is the pair list refresh period in seconds (12h).
+const DefaultRefreshPeriod = 43200
+
+// PairListResponse is the Freqtrade-style remote pair list payload.
+type PairListResponse struct {
+	Pairs         []string `json:"pairs"`
+	RefreshPeriod int      `json:"refresh_period"`
+}
+
+// GainersQuery holds the options shared by the ranking (gainers, losers, volume, volatile)
+// and pair list operations of every exchange.
+//
+// Thresholds bound the change and liquidity of the ranked tickers. SortBy selects a field
+// to sort by (descending) instead of the ranking order. The funding
+// rate bounds only keep perpetual tickers and a positive MinOpenInterestValue only
+// keeps derivative tickers; nil bounds are not applied. A non-nil Filter keeps only the
+// tickers matching the expression; see ParseFilter.
+//
+// EndingFilter is a comma-separated list of quote assets, e.g. "USDT,USDC,FDUSD".
+// IncludeFilter and ExcludeFilter are comma-separated lists matched in IncludeMode
+// (default base asset) and ExcludeMode (default substring); see NewMatcher. The Exclude
+// flags drop leveraged tokens, stablecoin pairs and fiat pairs; see Classify.
+//
+// A non-empty Window ranks by the price change and volume of that rolling window instead
+// of the last 24 hours; see RankWindow. A non-nil Check is called last, in ranking order,
+// with batches of tickers and reports which to keep; it is called for no more tickers
+// than the limit needs, since it may be expensive, e.g. fetch candles of every pair. A
+// positive CheckLimit bounds the number of tickers checked; the tickers ranked after
+// them are dropped.
+//
+// BaseCoin selects the underlying of the option market on exchanges that list options
+// per base coin; empty selects the exchange's default underlying.
+type GainersQuery struct {
+	Limit         int
+	EndingFilter  string
+	IncludeFilter string
+	IncludeMode   MatchMode
+	ExcludeFilter string
+	ExcludeMode   MatchMode
+	Filter        *filter.Program
+	Thresholds
+
+	ExcludeLeveraged   bool
+	ExcludeStablePairs bool
+	ExcludeFiatPairs   bool
+
+	Window     Window
+	Check      func([]Ticker) []bool
+	CheckLimit int
+
+	BaseCoin string
+
+	SortBy               string
+	MinFundingRate       *float64
+	MaxFundingRate       *float64
+	MinOpenInterestValue float64
+}
+
+// Sort keys accepted by GainersQuery.SortBy.
+const (
+	SortByChange            = "change"
+	SortByFundingRate       = "funding_rate"
+	SortByOpenInterest      = "open_interest"
+	SortByOpenInterestValue = "open_interest_value"
+)
+
+// IsValidSortBy reports whether sortBy is a supported sort key.
+func IsValidSortBy(sortBy string) bool {
+	switch sortBy {
+	case "", SortByChange, SortByFundingRate, SortByOpenInterest, SortByOpenInterestValue:
+		return true
+	default:
+		return false
+	}
+}
+
+// Option ranking keys accepted by OptionsQuery.RankBy.
+const (
+	RankByVolume       = "volume"
+	RankByTurnover     = "turnover"
+	RankByOpenInterest = "open_interest"
+	RankByIV           = "iv"
+	RankByChange       = "change"
+)
+
+// DefaultOptionRank ranks options by traded volume, since the price change of thinly
+// traded contracts says little about the market.
+const DefaultOptionRank = RankByVolume
+
+// OptionsQuery holds the options of the option ranking operation. An empty BaseCoin
+// selects the exchange's default underlying and an empty RankBy the DefaultOptionRank.
+type OptionsQuery struct {
+	BaseCoin      string
+	RankBy        string
+	Limit         int
+	ExcludeFilter string
+}
+
+// IsValidOptionRank reports whether rankBy is a supported option ranking key.
+func IsValidOptionRank(rankBy string) bool {
+	switch rankBy {
+	case RankByVolume, RankByTurnover, RankByOpenInterest, RankByIV, RankByChange:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,172 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnknownSymbol is returned when a symbol is not listed in the exchange metadata.
+var ErrUnknownSymbol = errors.New("unknown symbol")
+
+// SymbolInfo is the exchange metadata of a trading pair.
+type SymbolInfo struct {
+	Symbol     string  `json:"symbol"`
+	BaseAsset  string  `json:"base_asset"`
+	QuoteAsset string  `json:"quote_asset"`
+	Status     string  `json:"status"`
+	Trading    bool    `json:"trading"`
+	TickSize   float64 `json:"tick_size"`
+	LotSize    float64 `json:"lot_size"`
+	// ContractSize is the quote value of one contract on markets sized in contracts of a
+	// fixed quote value, e.g. Binance COIN-M; zero elsewhere.
+	ContractSize float64 `json:"contract_size,omitempty"`
+
+	Categories []Category `json:"categories,omitempty"`
+}
+
+// Symbols maps exchange symbols to their metadata.
+type Symbols map[string]SymbolInfo
+
+// Pair formats the symbol as "BASE/QUOTE" using its metadata. When the symbol is
+// unknown the quote is taken from the ending filter if the symbol ends with it,
+// otherwise it is split on a known quote suffix.
+func (s Symbols) Pair(symbol, endingFilter string) string {
+	if info, ok := s[symbol]; ok && info.BaseAsset != "" && info.QuoteAsset != "" {
+		return info.BaseAsset + "/" + info.QuoteAsset
+	}
+	if endingFilter != "" && len(symbol) > len(endingFilter) && strings.HasSuffix(symbol, endingFilter) {
+		return strings.TrimSuffix(symbol, endingFilter) + "/" + endingFilter
+	}
+	base, quote := SplitSymbol(symbol)
+	if quote == "" {
+		return symbol
+	}
+	return base + "/" + quote
+}
+
+// HasQuote reports whether the symbol is quoted in the given asset. Known symbols
+// are matched on their quote asset, unknown ones on the symbol suffix. An empty
+// quote matches every symbol.
+func (s Symbols) HasQuote(symbol, quote string) bool {
+	if quote == "" {
+		return true
+	}
+	if info, ok := s[symbol]; ok {
+		return info.QuoteAsset == quote
+	}
+	return strings.HasSuffix(symbol, quote)
+}
+
+// Enrich replaces the base and quote assets, the tick size and the categories of the
+// tickers with the metadata of known symbols.
+func (s Symbols) Enrich(tickers []Ticker) {
+	for i := range tickers {
+		if info, ok := s[tickers[i].Symbol]; ok {
+			tickers[i].BaseAsset = info.BaseAsset
+			tickers[i].QuoteAsset = info.QuoteAsset
+			tickers[i].TickSize = info.TickSize
+			tickers[i].Categories = info.Categories
+		}
+	}
+}
+
+// Validate returns ErrUnknownSymbol when the metadata is loaded and does not list the symbol.
+func (s Symbols) Validate(symbol string) error {
+	if len(s) == 0 {
+		return nil
+	}
+	if _, ok := s[symbol]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownSymbol, symbol)
+	}
+	return nil
+}
+
+// symbolFailureTTL is how long a failed metadata load is cached, so that an unavailable
+// metadata endpoint is not requested again by every ticker request.
+const symbolFailureTTL = 30 * time.Second
+
+// SymbolCache keeps the symbol metadata of every market in memory for a limited time.
+// Every market is loaded under its own lock, so a slow load does not block the others.
+type SymbolCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	failureTTL time.Duration
+	entries    map[string]*symbolEntry
+}
+
+type symbolEntry struct {
+	mu        sync.Mutex
+	symbols   Symbols
+	err       error
+	fetchedAt time.Time
+}
+
+// NewSymbolCache creates a cache whose entries expire after ttl.
+func NewSymbolCache(ttl time.Duration) *SymbolCache {
+	return &SymbolCache{ttl: ttl, failureTTL: symbolFailureTTL, entries: make(map[string]*symbolEntry)}
+}
+
+// Get returns the cached metadata of the market, calling load when it is missing or expired.
+// Concurrent callers of the same market wait for a single load, and a failed load is
+// reported again without calling load until symbolFailureTTL has passed. Loaded symbols
+// are tagged with their categories; see Symbols.Classify.
+func (c *SymbolCache) Get(market string, load func() (Symbols, error)) (Symbols, error) {
+	entry := c.entry(market)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if !entry.fetchedAt.IsZero() {
+		age := time.Since(entry.fetchedAt)
+		if entry.err == nil && age < c.ttl {
+			return entry.symbols, nil
+		}
+		if entry.err != nil && age < c.failureTTL {
+			return nil, entry.err
+		}
+	}
+
+	symbols, err := load()
+	entry.fetchedAt = time.Now()
+	if err != nil {
+		entry.err = err
+		return nil, err
+	}
+	symbols.Classify()
+	entry.symbols, entry.err = symbols, nil
+	return symbols, nil
+}
+
+// Load returns the cached metadata of a market of an exchange serving markets, see Get,
+// or an error without calling load when the market is not one of them.
+func (c *SymbolCache) Load(market string, markets []string, load func() (Symbols, error)) (Symbols, error) {
+	if err := RequireMarket(market, markets...); err != nil {
+		return nil, err
+	}
+	return c.Get(market, load)
+}
+
+// OrEmpty returns the cached metadata of the market, see Get, or empty Symbols when it
+// cannot be loaded. Metadata is best effort: callers fall back to splitting symbols.
+func (c *SymbolCache) OrEmpty(market string, load func() (Symbols, error)) Symbols {
+	symbols, err := c.Get(market, load)
+	if err != nil {
+		return Symbols{}
+	}
+	return symbols
+}
+
+// entry returns the cache entry of the market, creating it when missing.
+func (c *SymbolCache) entry(market string) *symbolEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[market]
+	if !ok {
+		entry = &symbolEntry{}
+		c.entries[market] = entry
+	}
+	return entry
+}
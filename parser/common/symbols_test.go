@@ -0,0 +1,117 @@
+package common
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSymbolsPair(t *testing.T) {
+	symbols := Symbols{
+		"BTCUSDT": {Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT"},
+		"ETHBTC":  {Symbol: "ETHBTC", BaseAsset: "ETH", QuoteAsset: "BTC"},
+	}
+
+	tests := []struct {
+		symbol, endingFilter, want string
+	}{
+		{"BTCUSDT", "", "BTC/USDT"},
+		{"BTCUSDT", "USDT", "BTC/USDT"},
+		{"ETHBTC", "USDT", "ETH/BTC"},
+		{"SOLUSDC", "", "SOL/USDC"},
+		{"SOLUSDC", "USDC", "SOL/USDC"},
+	}
+
+	for _, tt := range tests {
+		if got := symbols.Pair(tt.symbol, tt.endingFilter); got != tt.want {
+			t.Errorf("Pair(%q, %q) = %q; want %q", tt.symbol, tt.endingFilter, got, tt.want)
+		}
+	}
+}
+
+func TestSymbolsValidate(t *testing.T) {
+	symbols := Symbols{"BTCUSDT": {Symbol: "BTCUSDT"}}
+
+	if err := symbols.Validate("BTCUSDT"); err != nil {
+		t.Errorf("Expected no error, but got %v", err)
+	}
+	if err := symbols.Validate("NOPEUSDT"); !errors.Is(err, ErrUnknownSymbol) {
+		t.Errorf("Expected ErrUnknownSymbol, but got %v", err)
+	}
+	if err := (Symbols{}).Validate("NOPEUSDT"); err != nil {
+		t.Errorf("Expected no error without metadata, but got %v", err)
+	}
+}
+
+func TestSymbolCacheFailure(t *testing.T) {
+	cache := NewSymbolCache(time.Hour)
+	loads := 0
+	failure := errors.New("exchangeInfo unavailable")
+	load := func() (Symbols, error) {
+		loads++
+		return nil, failure
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := cache.Get("spot", load); !errors.Is(err, failure) {
+			t.Errorf("Expected the load error, but got %v", err)
+		}
+	}
+	if loads != 1 {
+		t.Errorf("Expected the failure to be cached after 1 load, but got %d loads", loads)
+	}
+
+	cache.failureTTL = 0
+	symbols, err := cache.Get("spot", func() (Symbols, error) {
+		return Symbols{"BTCUSDT": {Symbol: "BTCUSDT"}}, nil
+	})
+	if err != nil || len(symbols) != 1 {
+		t.Errorf("Expected a reload once the failure expired, but got %v, %v", symbols, err)
+	}
+}
+
+func TestSymbolCacheMarkets(t *testing.T) {
+	cache := NewSymbolCache(time.Hour)
+	loading := make(chan struct{})
+	release := make(chan struct{})
+	go cache.Get("spot", func() (Symbols, error) {
+		close(loading)
+		<-release
+		return Symbols{}, nil
+	})
+	<-loading
+	defer close(release)
+
+	done := make(chan struct{})
+	go func() {
+		cache.Get("linear", func() (Symbols, error) { return Symbols{}, nil })
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("Expected the linear load not to wait for the spot load")
+	}
+}
+
+func TestSymbolCacheLoad(t *testing.T) {
+	cache := NewSymbolCache(time.Hour)
+	loads := 0
+	load := func() (Symbols, error) {
+		loads++
+		return Symbols{"BTC-USD": {Symbol: "BTC-USD"}}, nil
+	}
+
+	if _, err := cache.Load("linear", []string{SpotMarket}, load); err == nil || loads != 0 {
+		t.Errorf("Expected an invalid market error without a load, but got %v after %d loads", err, loads)
+	}
+	if symbols, err := cache.Load(SpotMarket, []string{SpotMarket}, load); err != nil || len(symbols) != 1 {
+		t.Errorf("Expected the spot symbols, but got %v, %v", symbols, err)
+	}
+
+	failing := NewSymbolCache(time.Hour)
+	symbols := failing.OrEmpty(SpotMarket, func() (Symbols, error) { return nil, errors.New("unavailable") })
+	if symbols == nil || len(symbols) != 0 {
+		t.Errorf("Expected empty symbols, but got %v", symbols)
+	}
+}
@@ -0,0 +1,125 @@
+package common
+
+// Thresholds are the change and liquidity bounds a ticker must meet to be listed as a
+// gainer. Zero values are not applied: a zero MinChangePercent or MaxChangePercent means
+// unset, so a bound of exactly 0%, e.g. a maximum change of 0 to list losers only, cannot
+// be expressed; use a bound just below or above it, such as a maximum of -0.0001.
+//
+// MinQuoteVolume is compared with the USD-equivalent quote volume: volumes quoted in a
+// stablecoin or USD count as is, other quote assets are converted at their USD price
+// taken from the same set of tickers, and tickers whose quote asset has no USD price
+// are compared by their raw quote volume, as in the volume ranking. MaxSpreadPercent
+// drops tickers without a best bid and ask. MinTradeCount is only applied to exchanges
+// that report trade counts, i.e. tickers with a non-zero count. MaxTickPercent drops
+// dust-priced pairs whose price tick is more than that percentage of the last price; it
+// is only applied to tickers whose tick size is known from metadata.
+type Thresholds struct {
+	MinChangePercent float64 `json:"min_change_percent,omitempty"`
+	MaxChangePercent float64 `json:"max_change_percent,omitempty"`
+	MinQuoteVolume   float64 `json:"min_quote_volume,omitempty"`
+	MinTradeCount    int64   `json:"min_trade_count,omitempty"`
+	MinLastPrice     float64 `json:"min_last_price,omitempty"`
+	MaxSpreadPercent float64 `json:"max_spread_percent,omitempty"`
+	MaxTickPercent   float64 `json:"max_tick_percent,omitempty"`
+}
+
+// usdQuotes lists the quote assets counted at par with USD.
+var usdQuotes = map[string]bool{
+	"USD": true, "USDT": true, "USDC": true, "FDUSD": true, "BUSD": true, "TUSD": true, "DAI": true,
+}
+
+// ForRanking returns the default thresholds that apply to the ranking. The change bounds
+// are gainer bounds, e.g. a minimum change of 2% would empty the losers, so they are only
+// kept for RankingGainers.
+func (t Thresholds) ForRanking(ranking Ranking) Thresholds {
+	if ranking != RankingGainers {
+		t.MinChangePercent, t.MaxChangePercent = 0, 0
+	}
+	return t
+}
+
+// Filter returns the tickers that meet the thresholds, in their original order.
+func (t Thresholds) Filter(tickers []Ticker) []Ticker {
+	var rates map[string]float64
+	if t.MinQuoteVolume > 0 {
+		rates = USDRates(tickers)
+	}
+
+	filtered := make([]Ticker, 0, len(tickers))
+	for _, ticker := range tickers {
+		if t.matches(ticker, rates) {
+			filtered = append(filtered, ticker)
+		}
+	}
+	return filtered
+}
+
+// matches reports whether the ticker meets the thresholds, converting its quote volume
+// to USD with the given rates.
+func (t Thresholds) matches(ticker Ticker, rates map[string]float64) bool {
+	if t.MinChangePercent != 0 && ticker.ChangePercent < t.MinChangePercent {
+		return false
+	}
+	if t.MaxChangePercent != 0 && ticker.ChangePercent > t.MaxChangePercent {
+		return false
+	}
+	if t.MinQuoteVolume > 0 && usdVolume(ticker, rates) < t.MinQuoteVolume {
+		return false
+	}
+	if t.MinTradeCount > 0 && ticker.TradeCount > 0 && ticker.TradeCount < t.MinTradeCount {
+		return false
+	}
+	if t.MinLastPrice > 0 && ticker.LastPrice < t.MinLastPrice {
+		return false
+	}
+	if t.MaxSpreadPercent > 0 {
+		spread, ok := SpreadPercent(ticker)
+		if !ok || spread > t.MaxSpreadPercent {
+			return false
+		}
+	}
+	if t.MaxTickPercent > 0 {
+		if tick, ok := TickPercent(ticker); ok && tick > t.MaxTickPercent {
+			return false
+		}
+	}
+	return true
+}
+
+// USDRates returns the USD price of every quote asset found in the tickers. USD and
+// stablecoins are priced at 1; other assets are priced by the last price of their
+// ticker against a USD quote, e.g. BTC by BTCUSDT.
+func USDRates(tickers []Ticker) map[string]float64 {
+	rates := make(map[string]float64, len(usdQuotes))
+	for quote := range usdQuotes {
+		rates[quote] = 1
+	}
+	for _, t := range tickers {
+		if t.LastPrice <= 0 || !usdQuotes[t.QuoteAsset] || usdQuotes[t.BaseAsset] {
+			continue
+		}
+		if _, ok := rates[t.BaseAsset]; !ok && t.BaseAsset != "" {
+			rates[t.BaseAsset] = t.LastPrice
+		}
+	}
+	return rates
+}
+
+// SpreadPercent returns the bid/ask spread of the ticker as a percentage of the mid
+// price. It reports false when the ticker has no best bid and ask.
+func SpreadPercent(t Ticker) (float64, bool) {
+	if t.BidPrice <= 0 || t.AskPrice <= 0 {
+		return 0, false
+	}
+	mid := (t.BidPrice + t.AskPrice) / 2
+	return (t.AskPrice - t.BidPrice) / mid * 100, true
+}
+
+// TickPercent returns the price tick of the ticker as a percentage of its last price, i.e.
+// the smallest possible price move. It reports false when the tick size or price is unknown.
+func TickPercent(t Ticker) (float64, bool) {
+	if t.TickSize <= 0 || t.LastPrice <= 0 {
+		return 0, false
+	}
+	return t.TickSize / t.LastPrice * 100, true
+}
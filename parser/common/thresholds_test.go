@@ -0,0 +1,63 @@
+package common
+
+import "testing"
+
+func TestThresholdsFilter(t *testing.T) {
+	tickers := []Ticker{
+		{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", LastPrice: 50000, ChangePercent: 2, QuoteVolume: 5000000, TradeCount: 90000, BidPrice: 49999, AskPrice: 50001},
+		{Symbol: "ETHBTC", BaseAsset: "ETH", QuoteAsset: "BTC", LastPrice: 0.05, ChangePercent: 3, QuoteVolume: 40, TradeCount: 5000, BidPrice: 0.04999, AskPrice: 0.05001},
+		{Symbol: "DUSTUSDT", BaseAsset: "DUST", QuoteAsset: "USDT", LastPrice: 0.0001, ChangePercent: 0.01, QuoteVolume: 12, TradeCount: 3, BidPrice: 0.00009, AskPrice: 0.00011},
+		{Symbol: "MOONUSDT", BaseAsset: "MOON", QuoteAsset: "USDT", LastPrice: 1, ChangePercent: 400, QuoteVolume: 3000000, TradeCount: 20000, BidPrice: 0.99, AskPrice: 1.01},
+		{Symbol: "XYZABC", BaseAsset: "XYZ", QuoteAsset: "ABC", LastPrice: 1, ChangePercent: 5, QuoteVolume: 1e9},
+	}
+
+	tests := []struct {
+		name       string
+		thresholds Thresholds
+		want       []string
+	}{
+		{"none", Thresholds{}, []string{"BTCUSDT", "ETHBTC", "DUSTUSDT", "MOONUSDT", "XYZABC"}},
+		{"change", Thresholds{MinChangePercent: 1, MaxChangePercent: 100}, []string{"BTCUSDT", "ETHBTC", "XYZABC"}},
+		{"usd volume", Thresholds{MinQuoteVolume: 1000000}, []string{"BTCUSDT", "ETHBTC", "MOONUSDT", "XYZABC"}},
+		{"trade count", Thresholds{MinTradeCount: 1000}, []string{"BTCUSDT", "ETHBTC", "MOONUSDT", "XYZABC"}},
+		{"last price", Thresholds{MinLastPrice: 0.01}, []string{"BTCUSDT", "ETHBTC", "MOONUSDT", "XYZABC"}},
+		{"spread", Thresholds{MaxSpreadPercent: 0.5}, []string{"BTCUSDT", "ETHBTC"}},
+	}
+
+	for _, tt := range tests {
+		got := tt.thresholds.Filter(tickers)
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: expected %d tickers, but got %d", tt.name, len(tt.want), len(got))
+		}
+		for i, symbol := range tt.want {
+			if got[i].Symbol != symbol {
+				t.Errorf("%s: expected %s at position %d, but got %s", tt.name, symbol, i, got[i].Symbol)
+			}
+		}
+	}
+}
+
+func TestThresholdsForRanking(t *testing.T) {
+	defaults := Thresholds{MinChangePercent: 2, MaxChangePercent: 50, MinQuoteVolume: 1, MinTradeCount: 100}
+
+	if got := defaults.ForRanking(RankingGainers); got != defaults {
+		t.Errorf("Expected every default for gainers, but got %+v", got)
+	}
+	got := defaults.ForRanking(RankingLosers)
+	if got.MinChangePercent != 0 || got.MaxChangePercent != 0 || got.MinQuoteVolume != 1 || got.MinTradeCount != 100 {
+		t.Errorf("Expected the liquidity defaults only for losers, but got %+v", got)
+	}
+}
+
+func TestThresholdsMaxTickPercent(t *testing.T) {
+	tickers := []Ticker{
+		{Symbol: "BTCUSDT", LastPrice: 50000, TickSize: 0.01},
+		{Symbol: "DUSTUSDT", LastPrice: 0.00000123, TickSize: 0.00000001},
+		{Symbol: "NOMETAUSDT", LastPrice: 0.00000123},
+	}
+
+	got := Thresholds{MaxTickPercent: 0.1}.Filter(tickers)
+	if len(got) != 2 || got[0].Symbol != "BTCUSDT" || got[1].Symbol != "NOMETAUSDT" {
+		t.Errorf("Expected BTCUSDT and NOMETAUSDT, but got %+v", got)
+	}
+}
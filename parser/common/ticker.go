@@ -0,0 +1,96 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Ticker is the exchange-agnostic representation of 24-hour ticker statistics.
+//
+// Prices and volumes are numeric and ChangePercent is always expressed in
+// percent, i.e. 2.5 means +2.5% regardless of how the exchange reports it.
+// The derivative fields are only set for perpetual and futures markets that
+// report them; FundingRate is a fraction per funding interval as the exchanges
+// report it, and OpenInterestValue is the open interest in the quote asset.
+// TickSize and Categories are taken from the symbol metadata; see Classify.
+type Ticker struct {
+	Exchange      string    `json:"exchange"`
+	Market        string    `json:"market"`
+	Symbol        string    `json:"symbol"`
+	BaseAsset     string    `json:"base_asset"`
+	QuoteAsset    string    `json:"quote_asset"`
+	LastPrice     float64   `json:"last_price"`
+	OpenPrice     float64   `json:"open_price"`
+	HighPrice     float64   `json:"high_price"`
+	LowPrice      float64   `json:"low_price"`
+	BidPrice      float64   `json:"bid_price"`
+	BidQuantity   float64   `json:"bid_qty"`
+	AskPrice      float64   `json:"ask_price"`
+	AskQuantity   float64   `json:"ask_qty"`
+	Volume        float64   `json:"volume"`
+	QuoteVolume   float64   `json:"quote_volume"`
+	ChangePercent float64   `json:"change_percent"`
+	TradeCount    int64     `json:"trade_count"`
+	OpenTime      time.Time `json:"open_time"`
+	CloseTime     time.Time `json:"close_time"`
+
+	MarkPrice         float64    `json:"mark_price,omitempty"`
+	IndexPrice        float64    `json:"index_price,omitempty"`
+	FundingRate       float64    `json:"funding_rate,omitempty"`
+	NextFundingTime   *time.Time `json:"next_funding_time,omitempty"`
+	OpenInterest      float64    `json:"open_interest,omitempty"`
+	OpenInterestValue float64    `json:"open_interest_value,omitempty"`
+	Basis             float64    `json:"basis,omitempty"`
+
+	TickSize   float64    `json:"tick_size,omitempty"`
+	Categories []Category `json:"categories,omitempty"`
+}
+
+// knownQuotes lists the quote assets recognised by SplitSymbol, longest first
+// so that e.g. "FDUSD" wins over "USD".
+var knownQuotes = []string{
+	"FDUSD", "USDT", "USDC", "BUSD", "TUSD", "BIDR",
+	"DAI", "USD", "EUR", "GBP", "TRY", "BRL", "AUD", "JPY", "RUB", "UAH",
+	"ZAR", "PLN", "RON", "ARS", "MXN", "IDR", "NGN",
+	"BTC", "ETH", "BNB", "XRP", "TRX", "SOL", "DOT", "DOGE",
+}
+
+// SplitSymbol splits an exchange symbol into its base and quote assets.
+// Dash separated symbols such as "BTC-USDT" are split on the dash; concatenated
+// symbols such as "BTCUSDT" are split on the longest known quote suffix.
+// The quote is empty when it cannot be determined.
+func SplitSymbol(symbol string) (base, quote string) {
+	if parts := strings.Split(symbol, "-"); len(parts) > 1 {
+		if len(parts) == 2 {
+			return parts[0], parts[1]
+		}
+		return parts[0], ""
+	}
+
+	best := ""
+	for _, q := range knownQuotes {
+		if len(q) > len(best) && len(symbol) > len(q) && strings.HasSuffix(symbol, q) {
+			best = q
+		}
+	}
+	return strings.TrimSuffix(symbol, best), best
+}
+
+// ParseFloat parses a numeric string returned by an exchange, treating empty
+// or malformed values as zero.
+func ParseFloat(s string) float64 {
+	f, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// ChangePercent returns the percent change from open to last, or zero when open is zero.
+func ChangePercent(open, last float64) float64 {
+	if open == 0 {
+		return 0
+	}
+	return (last - open) / open * 100
+}
@@ -0,0 +1,33 @@
+package common
+
+import "testing"
+
+func TestSplitSymbol(t *testing.T) {
+	tests := []struct {
+		symbol, base, quote string
+	}{
+		{"BTCUSDT", "BTC", "USDT"},
+		{"ETHFDUSD", "ETH", "FDUSD"},
+		{"ETHBTC", "ETH", "BTC"},
+		{"BTCUSD", "BTC", "USD"},
+		{"BTC-USDT", "BTC", "USDT"},
+		{"BTC-29DEC23-40000-C", "BTC", ""},
+		{"USDT", "USDT", ""},
+	}
+
+	for _, tt := range tests {
+		base, quote := SplitSymbol(tt.symbol)
+		if base != tt.base || quote != tt.quote {
+			t.Errorf("SplitSymbol(%q) = %q, %q; want %q, %q", tt.symbol, base, quote, tt.base, tt.quote)
+		}
+	}
+}
+
+func TestChangePercent(t *testing.T) {
+	if got := ChangePercent(100, 102.5); got != 2.5 {
+		t.Errorf("Expected 2.5, but got %v", got)
+	}
+	if got := ChangePercent(0, 1); got != 0 {
+		t.Errorf("Expected 0 for a zero open price, but got %v", got)
+	}
+}
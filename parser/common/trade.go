@@ -0,0 +1,98 @@
+package common
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// ErrUnsupportedAggregate is returned when an exchange serves no aggregated trades for a market.
+var ErrUnsupportedAggregate = errors.New("aggregated trades are not supported")
+
+// Trade defaults: the number of recent trades requested and of largest trades reported.
+const (
+	DefaultTradeLimit    = 500
+	DefaultLargestTrades = 5
+)
+
+// Taker sides of a trade.
+const (
+	SideBuy  = "buy"
+	SideSell = "sell"
+)
+
+// TradeQuery selects the recent trades of a pair. Aggregate requests trades aggregated by
+// taker order where the exchange serves them.
+type TradeQuery struct {
+	Limit     int
+	Aggregate bool
+}
+
+// Trade is the exchange-agnostic representation of a public trade. Side is the side of
+// the taker, so a buy lifted the ask.
+type Trade struct {
+	ID            string    `json:"id"`
+	Price         float64   `json:"price"`
+	Quantity      float64   `json:"quantity"`
+	QuoteQuantity float64   `json:"quote_quantity"`
+	Side          string    `json:"side"`
+	Time          time.Time `json:"time"`
+}
+
+// TradeFlow are the taker flow metrics of a series of trades: the volume bought and sold
+// by takers in the quote asset, their ratio, the trade rate and the largest trades. The
+// window spans from the first to the last trade. BuySellRatio is nil without taker
+// sells, and TradesPerMinute is nil when the window is empty.
+type TradeFlow struct {
+	Count           int       `json:"count"`
+	From            time.Time `json:"from"`
+	To              time.Time `json:"to"`
+	BuyVolume       float64   `json:"buy_volume"`
+	SellVolume      float64   `json:"sell_volume"`
+	BuySellRatio    *float64  `json:"buy_sell_ratio"`
+	TradesPerMinute *float64  `json:"trades_per_minute"`
+	Largest         []Trade   `json:"largest"`
+}
+
+// TradeReport is a series of recent trades, oldest first, with their taker flow metrics.
+type TradeReport struct {
+	Exchange string    `json:"exchange"`
+	Market   string    `json:"market"`
+	Symbol   string    `json:"symbol"`
+	Trades   []Trade   `json:"trades"`
+	Metrics  TradeFlow `json:"metrics"`
+}
+
+// AnalyzeTrades returns the taker flow metrics of the trades, oldest first, reporting
+// the largest trades by quote quantity.
+func AnalyzeTrades(trades []Trade, largest int) TradeFlow {
+	flow := TradeFlow{Count: len(trades), Largest: []Trade{}}
+	if len(trades) == 0 {
+		return flow
+	}
+	flow.From, flow.To = trades[0].Time, trades[len(trades)-1].Time
+
+	for _, t := range trades {
+		switch t.Side {
+		case SideBuy:
+			flow.BuyVolume += t.QuoteQuantity
+		case SideSell:
+			flow.SellVolume += t.QuoteQuantity
+		}
+	}
+	if flow.SellVolume > 0 {
+		ratio := flow.BuyVolume / flow.SellVolume
+		flow.BuySellRatio = &ratio
+	}
+	if minutes := flow.To.Sub(flow.From).Minutes(); minutes > 0 {
+		rate := float64(len(trades)) / minutes
+		flow.TradesPerMinute = &rate
+	}
+
+	sorted := append([]Trade(nil), trades...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].QuoteQuantity > sorted[j].QuoteQuantity
+	})
+	flow.Largest = sorted[:min(largest, len(sorted))]
+	return flow
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAnalyzeTrades(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	trades := []Trade{
+		{ID: "1", QuoteQuantity: 300, Side: SideBuy, Time: start},
+		{ID: "2", QuoteQuantity: 100, Side: SideSell, Time: start.Add(30 * time.Second)},
+		{ID: "3", QuoteQuantity: 500, Side: SideBuy, Time: start.Add(time.Minute)},
+		{ID: "4", QuoteQuantity: 100, Side: SideSell, Time: start.Add(2 * time.Minute)},
+	}
+
+	flow := AnalyzeTrades(trades, 2)
+	if flow.Count != 4 || !flow.From.Equal(start) || !flow.To.Equal(start.Add(2*time.Minute)) {
+		t.Errorf("Expected 4 trades over 2 minutes, but got %+v", flow)
+	}
+	if flow.BuyVolume != 800 || flow.SellVolume != 200 {
+		t.Errorf("Expected buy and sell volumes 800 and 200, but got %v and %v", flow.BuyVolume, flow.SellVolume)
+	}
+	if flow.BuySellRatio == nil || *flow.BuySellRatio != 4 {
+		t.Errorf("Expected a buy/sell ratio of 4, but got %v", flow.BuySellRatio)
+	}
+	if flow.TradesPerMinute == nil || *flow.TradesPerMinute != 2 {
+		t.Errorf("Expected 2 trades per minute, but got %v", flow.TradesPerMinute)
+	}
+	if len(flow.Largest) != 2 || flow.Largest[0].ID != "3" || flow.Largest[1].ID != "1" {
+		t.Errorf("Expected trades 3 and 1 as the largest, but got %+v", flow.Largest)
+	}
+	if trades[0].ID != "1" {
+		t.Errorf("Expected the trades to keep their order, but got %+v", trades)
+	}
+
+	flow = AnalyzeTrades(trades[:1], 5)
+	if flow.BuySellRatio != nil || flow.TradesPerMinute != nil || len(flow.Largest) != 1 {
+		t.Errorf("Expected no ratio or rate for a single buy, but got %+v", flow)
+	}
+	if flow := AnalyzeTrades(nil, 5); flow.Count != 0 || flow.Largest == nil {
+		t.Errorf("Expected empty metrics, but got %+v", flow)
+	}
+}
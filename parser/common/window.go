@@ -0,0 +1,122 @@
+package common
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// ErrUnsupportedWindow is returned when an exchange cannot rank a market over a window.
+var ErrUnsupportedWindow = errors.New("unsupported window")
+
+// Window is a rolling ticker window ending now.
+type Window string
+
+const (
+	Window1h Window = "1h"
+	Window4h Window = "4h"
+	Window1d Window = "1d"
+	Window7d Window = "7d"
+)
+
+// IsValidWindow reports whether window is a supported rolling window; "" selects the
+// 24-hour ticker.
+func IsValidWindow(window Window) bool {
+	switch window {
+	case "", Window1h, Window4h, Window1d, Window7d:
+		return true
+	default:
+		return false
+	}
+}
+
+// Duration returns the length of the window, or zero for an unsupported window.
+func (w Window) Duration() time.Duration {
+	switch w {
+	case Window1h:
+		return time.Hour
+	case Window4h:
+		return 4 * time.Hour
+	case Window1d:
+		return 24 * time.Hour
+	case Window7d:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// MaxWindowCandidates is the most candidates whose window statistics are fetched. Window
+// statistics cost a request per pair or per small batch of pairs, so an unfiltered window
+// ranking would otherwise spend most of the rate limit of the exchange.
+const MaxWindowCandidates = 200
+
+// WindowCandidates returns the 24-hour tickers worth fetching window statistics for: those
+// matching the ending, include, exclude and category filters of the query and meeting its
+// thresholds other than the change bounds, capped to the MaxWindowCandidates with the
+// largest 24-hour USD volume, most traded first. Liquidity is judged over 24 hours, so that
+// short windows are not emptied by volume bounds meant for a full day.
+func WindowCandidates(tickers []Ticker, query GainersQuery) []Ticker {
+	thresholds := query.Thresholds
+	thresholds.MinChangePercent, thresholds.MaxChangePercent = 0, 0
+	matchSymbol := symbolFilter(query, baseAssets(tickers))
+
+	candidates := make([]Ticker, 0)
+	for _, t := range thresholds.Filter(tickers) {
+		if t, ok := matchSymbol(t); ok {
+			candidates = append(candidates, t)
+		}
+	}
+
+	rates := USDRates(tickers)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return usdVolume(candidates[i], rates) > usdVolume(candidates[j], rates)
+	})
+	if len(candidates) > MaxWindowCandidates {
+		candidates = candidates[:MaxWindowCandidates]
+	}
+	return candidates
+}
+
+// Windowed returns the daily tickers that have a window ticker, with the prices, change,
+// volumes, trade count and times of the window ticker. The other fields, e.g. the best bid
+// and ask and the metadata, are kept from the daily ticker.
+func Windowed(daily []Ticker, window []Ticker) []Ticker {
+	bySymbol := make(map[string]Ticker, len(daily))
+	for _, t := range daily {
+		bySymbol[t.Symbol] = t
+	}
+
+	windowed := make([]Ticker, 0, len(window))
+	for _, w := range window {
+		t, ok := bySymbol[w.Symbol]
+		if !ok {
+			continue
+		}
+		t.LastPrice = w.LastPrice
+		t.OpenPrice = w.OpenPrice
+		t.HighPrice = w.HighPrice
+		t.LowPrice = w.LowPrice
+		t.Volume = w.Volume
+		t.QuoteVolume = w.QuoteVolume
+		t.ChangePercent = w.ChangePercent
+		t.TradeCount = w.TradeCount
+		t.OpenTime = w.OpenTime
+		if !w.CloseTime.IsZero() {
+			t.CloseTime = w.CloseTime
+		}
+		windowed = append(windowed, t)
+	}
+	return windowed
+}
+
+// RankWindow ranks tickers carrying window statistics, e.g. from Windowed. Only the change
+// bounds of the query thresholds are applied, since WindowCandidates already applied the
+// others to the 24-hour tickers; the other filters are applied as in Rank.
+func RankWindow(windowed []Ticker, ranking Ranking, query GainersQuery) []Ticker {
+	query.Thresholds = Thresholds{
+		MinChangePercent: query.MinChangePercent,
+		MaxChangePercent: query.MaxChangePercent,
+	}
+	return Rank(windowed, ranking, query)
+}
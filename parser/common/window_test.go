@@ -0,0 +1,54 @@
+package common
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRankWindow(t *testing.T) {
+	daily := []Ticker{
+		{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", LastPrice: 50000, ChangePercent: 8, QuoteVolume: 5000000, BidPrice: 49999, AskPrice: 50001},
+		{Symbol: "ETHUSDT", BaseAsset: "ETH", QuoteAsset: "USDT", LastPrice: 3000, ChangePercent: 1, QuoteVolume: 2000000},
+		{Symbol: "SOLUSDT", BaseAsset: "SOL", QuoteAsset: "USDT", LastPrice: 100, ChangePercent: 20, QuoteVolume: 1000},
+		{Symbol: "ETHBTC", BaseAsset: "ETH", QuoteAsset: "BTC", LastPrice: 0.06, ChangePercent: 2, QuoteVolume: 100},
+	}
+	query := GainersQuery{
+		EndingFilter: "USDT",
+		Thresholds:   Thresholds{MinQuoteVolume: 100000, MinChangePercent: 2},
+	}
+
+	candidates := WindowCandidates(daily, query)
+	if len(candidates) != 2 || candidates[0].Symbol != "BTCUSDT" || candidates[1].Symbol != "ETHUSDT" {
+		t.Fatalf("Expected the liquid USDT pairs BTCUSDT and ETHUSDT, but got %+v", candidates)
+	}
+
+	window := []Ticker{
+		{Symbol: "BTCUSDT", LastPrice: 50000, OpenPrice: 49900, ChangePercent: 0.2, QuoteVolume: 200000},
+		{Symbol: "ETHUSDT", LastPrice: 3000, OpenPrice: 2850, ChangePercent: 5.26, QuoteVolume: 90000},
+	}
+	windowed := Windowed(candidates, window)
+	if windowed[0].BidPrice != 49999 || windowed[0].ChangePercent != 0.2 || windowed[0].QuoteVolume != 200000 {
+		t.Errorf("Expected the window change and volume with the daily bid, but got %+v", windowed[0])
+	}
+
+	ranked := RankWindow(windowed, RankingGainers, query)
+	if len(ranked) != 1 || ranked[0].Symbol != "ETHUSDT" {
+		t.Errorf("Expected ETHUSDT, the only pair with a window change of at least 2%%, but got %+v", ranked)
+	}
+}
+
+func TestWindowCandidatesCap(t *testing.T) {
+	daily := make([]Ticker, 0, MaxWindowCandidates+50)
+	for i := 0; i < MaxWindowCandidates+50; i++ {
+		base := fmt.Sprintf("C%d", i)
+		daily = append(daily, Ticker{Symbol: base + "USDT", BaseAsset: base, QuoteAsset: "USDT", LastPrice: 1, QuoteVolume: float64(i)})
+	}
+
+	candidates := WindowCandidates(daily, GainersQuery{})
+	if len(candidates) != MaxWindowCandidates {
+		t.Fatalf("Expected %d candidates, but got %d", MaxWindowCandidates, len(candidates))
+	}
+	if candidates[0].Symbol != daily[len(daily)-1].Symbol || candidates[len(candidates)-1].QuoteVolume != 50 {
+		t.Errorf("Expected the most traded pairs first, but got %s to %s", candidates[0].Symbol, candidates[len(candidates)-1].Symbol)
+	}
+}
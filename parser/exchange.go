@@ -0,0 +1,159 @@
+package parser
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// Exchange is implemented by every exchange client served by the API.
+//
+// Ticker payloads are returned in the exchange's native format so that each
+// venue keeps the fields it reports; NormalizedTickers provides the same data
+// in a single cross-exchange model.
+//
+// Exchanges that fetch tickers symbol by symbol may return the tickers they fetched
+// together with a *common.PartialError listing the symbols they could not; callers
+// should serve those tickers and report the error.
+type Exchange interface {
+	// Name returns the key the exchange is registered under, e.g. "binance".
+	Name() string
+	// Markets returns the supported market types; the first one is the default.
+	Markets() []string
+	// Tickers returns 24-hour ticker data for every trading pair in a market.
+	Tickers(market string) (interface{}, error)
+	// Ticker returns 24-hour ticker data for a single trading pair in a market.
+	Ticker(market, pair string) (interface{}, error)
+	// Rank returns the trading pairs of a market kept and ordered by the ranking,
+	// e.g. the top gainers or losers.
+	Rank(market string, ranking common.Ranking, query common.GainersQuery) (interface{}, error)
+	// RankPairs returns the ranked trading pairs of a market as a pair list.
+	RankPairs(market string, ranking common.Ranking, query common.GainersQuery) (common.PairListResponse, error)
+	// NormalizedTickers returns 24-hour ticker data for every trading pair in a market
+	// converted to the exchange-agnostic common.Ticker.
+	NormalizedTickers(market string) ([]common.Ticker, error)
+	// Symbols returns the metadata of every symbol in a market, cached in memory.
+	Symbols(market string) (common.Symbols, error)
+}
+
+// OptionExchange is implemented by exchanges that list option tickers per base coin.
+// The handler uses it for the option market so that the underlying can be chosen and
+// options are ranked by option-specific keys rather than by price change alone.
+type OptionExchange interface {
+	Exchange
+	// OptionMarket returns the market type that lists options, e.g. "option".
+	OptionMarket() string
+	// OptionTickers returns the tickers, including Greeks, of every option on a base coin.
+	// An empty base coin selects the exchange's default underlying.
+	OptionTickers(baseCoin string) (interface{}, error)
+	// NormalizedOptionTickers returns the option tickers of a base coin converted to the
+	// exchange-agnostic common.Ticker.
+	NormalizedOptionTickers(baseCoin string) ([]common.Ticker, error)
+	// Options returns the options of a base coin ranked by the query.
+	Options(query common.OptionsQuery) (interface{}, error)
+	// OptionPairs returns the options of a base coin ranked by the query as a pair list.
+	OptionPairs(query common.OptionsQuery) (common.PairListResponse, error)
+}
+
+// BatchExchange is implemented by exchanges that return the tickers of several symbols
+// from one request, either with a native multi-symbol query or by filtering a bulk response.
+type BatchExchange interface {
+	Exchange
+	// TickersFor returns the native tickers of the symbols in the given market, in request
+	// order, and an error for every symbol the market does not list.
+	TickersFor(market string, symbols []string) (interface{}, []common.SymbolError, error)
+}
+
+// WindowExchange is implemented by exchanges that rank tickers over rolling windows
+// shorter or longer than 24 hours; see common.GainersQuery.Window.
+type WindowExchange interface {
+	Exchange
+	// SupportsWindow reports whether the rankings of the market accept the window.
+	SupportsWindow(market string, window common.Window) bool
+}
+
+// CandleExchange is implemented by exchanges that serve historical candles (klines).
+type CandleExchange interface {
+	Exchange
+	// Candles returns the candles of a trading pair of a market selected by the query,
+	// oldest first.
+	Candles(market, pair string, query common.CandleQuery) ([]common.Candle, error)
+}
+
+// DepthExchange is implemented by exchanges that serve order book snapshots.
+type DepthExchange interface {
+	Exchange
+	// OrderBook returns the order book of a trading pair of a market with up to limit
+	// levels per side, or fewer where the exchange caps the depth.
+	OrderBook(market, pair string, limit int) (common.OrderBook, error)
+}
+
+// TradeExchange is implemented by exchanges that serve recent public trades.
+type TradeExchange interface {
+	Exchange
+	// Trades returns the most recent trades of a trading pair of a market, oldest first,
+	// up to the query's limit or fewer where the exchange caps it.
+	Trades(market, pair string, query common.TradeQuery) ([]common.Trade, error)
+}
+
+// Registry holds the configured exchanges keyed by name.
+type Registry struct {
+	mu        sync.RWMutex
+	exchanges map[string]Exchange
+	names     []string
+}
+
+// NewRegistry creates an empty exchange registry.
+func NewRegistry() *Registry {
+	return &Registry{exchanges: make(map[string]Exchange)}
+}
+
+// Register adds an exchange to the registry. Registering the same name twice is an error.
+func (r *Registry) Register(exchange Exchange) error {
+	name := exchange.Name()
+	if name == "" {
+		return fmt.Errorf("exchange name must not be empty")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.exchanges[name]; ok {
+		return fmt.Errorf("exchange %q is already registered", name)
+	}
+	r.exchanges[name] = exchange
+	r.names = append(r.names, name)
+	return nil
+}
+
+// Get returns the exchange registered under name.
+func (r *Registry) Get(name string) (Exchange, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	exchange, ok := r.exchanges[name]
+	return exchange, ok
+}
+
+// Names returns the registered exchange names in registration order.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, len(r.names))
+	copy(names, r.names)
+	return names
+}
+
+// All returns the registered exchanges in registration order.
+func (r *Registry) All() []Exchange {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	exchanges := make([]Exchange, 0, len(r.names))
+	for _, name := range r.names {
+		exchanges = append(exchanges, r.exchanges[name])
+	}
+	return exchanges
+}
@@ -0,0 +1,90 @@
+// Package filter implements the boolean expression language of screener filters, e.g.
+//
+//	quote == "USDT" && change_pct > 5 && quote_volume > 1e6 && !(base in ["BNB", "FDUSD"])
+//
+// An expression combines comparisons (==, !=, <, <=, >, >=), list membership (in),
+// negation (!) and the logical operators && and || over number, string and boolean
+// literals, list literals and named variables. Expressions are type checked against the
+// variables when they are parsed, so evaluating a parsed Program cannot fail. Number
+// literals may be negative, e.g. change_pct < -5; there is no arithmetic.
+package filter
+
+import "fmt"
+
+// Kind is the type of a value.
+type Kind int
+
+const (
+	Number Kind = iota
+	String
+	Bool
+	List
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Number:
+		return "number"
+	case String:
+		return "string"
+	case Bool:
+		return "bool"
+	case List:
+		return "list"
+	default:
+		return "unknown"
+	}
+}
+
+// Vars declares the variables an expression may reference and their kinds.
+type Vars map[string]Kind
+
+// Lookup returns the value of a declared variable: a float64 for Number, a string for
+// String and a bool for Bool variables.
+type Lookup func(name string) interface{}
+
+// Error is a parse or type error, located at the offending token of the expression.
+type Error struct {
+	// Pos is the byte offset of the token in the expression.
+	Pos int
+	// Token is the text of the token; it is empty at the end of the expression.
+	Token string
+	Msg   string
+}
+
+func (e *Error) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("%s at end of expression", e.Msg)
+	}
+	return fmt.Sprintf("%s at position %d near %q", e.Msg, e.Pos, e.Token)
+}
+
+// Program is a parsed, type checked expression.
+type Program struct {
+	source string
+	root   node
+}
+
+// Parse parses the expression and checks it against the declared variables. The
+// expression must be boolean. Errors are returned as *Error.
+func Parse(source string, vars Vars) (*Program, error) {
+	p, err := newParser(source, vars)
+	if err != nil {
+		return nil, err
+	}
+	root, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	return &Program{source: source, root: root}, nil
+}
+
+// Match evaluates the expression with the variable values returned by lookup.
+func (p *Program) Match(lookup Lookup) bool {
+	return p.root.eval(lookup).(bool)
+}
+
+// String returns the source of the expression.
+func (p *Program) String() string {
+	return p.source
+}
@@ -0,0 +1,87 @@
+package filter
+
+import (
+	"errors"
+	"testing"
+)
+
+var testVars = Vars{
+	"base":         String,
+	"quote":        String,
+	"change_pct":   Number,
+	"quote_volume": Number,
+	"leveraged":    Bool,
+}
+
+func testLookup(values map[string]interface{}) Lookup {
+	return func(name string) interface{} { return values[name] }
+}
+
+func TestMatch(t *testing.T) {
+	btc := testLookup(map[string]interface{}{"base": "BTC", "quote": "USDT", "change_pct": 6.0, "quote_volume": 5e6, "leveraged": false})
+	bnb := testLookup(map[string]interface{}{"base": "BNB", "quote": "USDT", "change_pct": 8.0, "quote_volume": 2e6, "leveraged": false})
+
+	tests := []struct {
+		expression string
+		btc, bnb   bool
+	}{
+		{`quote == "USDT" && change_pct > 5 && quote_volume > 1e6 && !(base in ["BNB","FDUSD"])`, true, false},
+		{`change_pct >= 8 || base == "BTC"`, true, true},
+		{`!leveraged && quote_volume < 3e6`, false, true},
+		{`base in []`, false, false},
+		{`base != "BTC" && change_pct <= 8.0`, false, true},
+		{`true && (false || base > "BA")`, true, true},
+		{`leveraged == false`, true, true},
+		{`change_pct < -5`, false, false},
+		{`change_pct > -5 && change_pct < 7`, true, false},
+		{`change_pct in [-1, 2, 6]`, true, false},
+		{`-1e6 < quote_volume`, true, true},
+		{`!(change_pct<-.5)`, true, true},
+	}
+
+	for _, tt := range tests {
+		program, err := Parse(tt.expression, testVars)
+		if err != nil {
+			t.Fatalf("%s: expected no error, but got %v", tt.expression, err)
+		}
+		if got := program.Match(btc); got != tt.btc {
+			t.Errorf("%s: expected %v for BTC, but got %v", tt.expression, tt.btc, got)
+		}
+		if got := program.Match(bnb); got != tt.bnb {
+			t.Errorf("%s: expected %v for BNB, but got %v", tt.expression, tt.bnb, got)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		pos        int
+		token      string
+	}{
+		{`change_pct > `, 13, ""},
+		{`chnage_pct > 5`, 0, "chnage_pct"},
+		{`quote == 5`, 9, "5"},
+		{`change_pct > 5 &&`, 17, ""},
+		{`change_pct > 5 ) `, 15, ")"},
+		{`base in ["BNB", 5]`, 16, "5"},
+		{`quote == "USDT`, 9, `"USDT`},
+		{`change_pct`, 0, "change_pct"},
+		{`change_pct > 5 # 1`, 15, "#"},
+		{`!quote`, 1, "quote"},
+		{``, 0, ""},
+		{`change_pct - 5 > 0`, 11, "-"},
+		{`change_pct > -`, 13, "-"},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(tt.expression, testVars)
+		var parseErr *Error
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("%s: expected *Error, but got %v", tt.expression, err)
+		}
+		if parseErr.Pos != tt.pos || parseErr.Token != tt.token {
+			t.Errorf("%s: expected error at %d near %q, but got %v", tt.expression, tt.pos, tt.token, parseErr)
+		}
+	}
+}
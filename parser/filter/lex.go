@@ -0,0 +1,169 @@
+package filter
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenTrue
+	tokenFalse
+	tokenIn
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenEq
+	tokenNe
+	tokenLt
+	tokenLe
+	tokenGt
+	tokenGe
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+	tokenComma
+)
+
+type token struct {
+	typ  tokenType
+	pos  int
+	text string
+	// value holds the decoded number or string of a literal.
+	value interface{}
+}
+
+// operators lists the operator and punctuation tokens, two-character operators first.
+var operators = []struct {
+	text string
+	typ  tokenType
+}{
+	{"&&", tokenAnd}, {"||", tokenOr}, {"==", tokenEq}, {"!=", tokenNe},
+	{"<=", tokenLe}, {">=", tokenGe}, {"<", tokenLt}, {">", tokenGt}, {"!", tokenNot},
+	{"(", tokenLParen}, {")", tokenRParen}, {"[", tokenLBracket}, {"]", tokenRBracket},
+	{",", tokenComma},
+}
+
+var keywords = map[string]tokenType{
+	"true":  tokenTrue,
+	"false": tokenFalse,
+	"in":    tokenIn,
+}
+
+// lex splits the expression into tokens, ending with a tokenEOF.
+func lex(source string) ([]token, error) {
+	var tokens []token
+	pos := 0
+	for pos < len(source) {
+		r := rune(source[pos])
+		switch {
+		case unicode.IsSpace(r):
+			pos++
+		case r == '"':
+			tok, err := lexString(source, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, tok)
+			pos += len(tok.text)
+		case r >= '0' && r <= '9' || r == '.' || r == '-' && signed(source, pos, tokens):
+			tok, err := lexNumber(source, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, tok)
+			pos += len(tok.text)
+		case r == '_' || unicode.IsLetter(r):
+			end := pos
+			for end < len(source) && (source[end] == '_' || unicode.IsLetter(rune(source[end])) || unicode.IsDigit(rune(source[end]))) {
+				end++
+			}
+			text := source[pos:end]
+			typ, ok := keywords[text]
+			if !ok {
+				typ = tokenIdent
+			}
+			tokens = append(tokens, token{typ: typ, pos: pos, text: text})
+			pos = end
+		default:
+			tok, ok := lexOperator(source, pos)
+			if !ok {
+				return nil, &Error{Pos: pos, Token: string(r), Msg: "unexpected character"}
+			}
+			tokens = append(tokens, tok)
+			pos += len(tok.text)
+		}
+	}
+	return append(tokens, token{typ: tokenEOF, pos: len(source)}), nil
+}
+
+// signed reports whether the '-' at pos starts a negative number: it must be followed by
+// a digit or '.' and come first or after an operator, '(', '[' or ',' rather than after
+// a value.
+func signed(source string, pos int, tokens []token) bool {
+	if pos+1 >= len(source) || !(source[pos+1] >= '0' && source[pos+1] <= '9' || source[pos+1] == '.') {
+		return false
+	}
+	if len(tokens) == 0 {
+		return true
+	}
+	switch tokens[len(tokens)-1].typ {
+	case tokenIdent, tokenNumber, tokenString, tokenTrue, tokenFalse, tokenRParen, tokenRBracket:
+		return false
+	}
+	return true
+}
+
+func lexOperator(source string, pos int) (token, bool) {
+	for _, op := range operators {
+		if strings.HasPrefix(source[pos:], op.text) {
+			return token{typ: op.typ, pos: pos, text: op.text}, true
+		}
+	}
+	return token{}, false
+}
+
+func lexString(source string, pos int) (token, error) {
+	for end := pos + 1; end < len(source); end++ {
+		switch source[end] {
+		case '\\':
+			end++
+		case '"':
+			text := source[pos : end+1]
+			value, err := strconv.Unquote(text)
+			if err != nil {
+				return token{}, &Error{Pos: pos, Token: text, Msg: "invalid string"}
+			}
+			return token{typ: tokenString, pos: pos, text: text, value: value}, nil
+		}
+	}
+	return token{}, &Error{Pos: pos, Token: source[pos:], Msg: "unterminated string"}
+}
+
+func lexNumber(source string, pos int) (token, error) {
+	end := pos
+	if source[pos] == '-' {
+		end++
+	}
+	for end < len(source) {
+		c := source[end]
+		isExponentSign := (c == '+' || c == '-') && end > pos+1 && (source[end-1] == 'e' || source[end-1] == 'E')
+		if !(c >= '0' && c <= '9' || c == '.' || c == 'e' || c == 'E' || isExponentSign) {
+			break
+		}
+		end++
+	}
+	text := source[pos:end]
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return token{}, &Error{Pos: pos, Token: text, Msg: "invalid number"}
+	}
+	return token{typ: tokenNumber, pos: pos, text: text, value: value}, nil
+}
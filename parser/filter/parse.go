@@ -0,0 +1,332 @@
+package filter
+
+import "fmt"
+
+// node is a type checked expression node.
+type node interface {
+	kind() Kind
+	eval(lookup Lookup) interface{}
+}
+
+type literal struct {
+	k     Kind
+	value interface{}
+}
+
+func (n literal) kind() Kind              { return n.k }
+func (n literal) eval(Lookup) interface{} { return n.value }
+
+type variable struct {
+	k    Kind
+	name string
+}
+
+func (n variable) kind() Kind                { return n.k }
+func (n variable) eval(l Lookup) interface{} { return l(n.name) }
+
+// list is a list literal; elem is the kind of its elements.
+type list struct {
+	elem   Kind
+	values []interface{}
+}
+
+func (n list) kind() Kind              { return List }
+func (n list) eval(Lookup) interface{} { return n.values }
+
+type not struct{ operand node }
+
+func (n not) kind() Kind                { return Bool }
+func (n not) eval(l Lookup) interface{} { return !n.operand.eval(l).(bool) }
+
+type logical struct {
+	op          tokenType
+	left, right node
+}
+
+func (n logical) kind() Kind { return Bool }
+func (n logical) eval(l Lookup) interface{} {
+	if n.op == tokenAnd {
+		return n.left.eval(l).(bool) && n.right.eval(l).(bool)
+	}
+	return n.left.eval(l).(bool) || n.right.eval(l).(bool)
+}
+
+type comparison struct {
+	op          tokenType
+	left, right node
+}
+
+func (n comparison) kind() Kind { return Bool }
+func (n comparison) eval(l Lookup) interface{} {
+	left, right := n.left.eval(l), n.right.eval(l)
+	switch n.op {
+	case tokenEq:
+		return left == right
+	case tokenNe:
+		return left != right
+	}
+
+	var cmp int
+	switch left := left.(type) {
+	case float64:
+		cmp = compare(left, right.(float64))
+	case string:
+		cmp = compare(left, right.(string))
+	}
+	switch n.op {
+	case tokenLt:
+		return cmp < 0
+	case tokenLe:
+		return cmp <= 0
+	case tokenGt:
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+type membership struct {
+	value node
+	list  node
+}
+
+func (n membership) kind() Kind { return Bool }
+func (n membership) eval(l Lookup) interface{} {
+	value := n.value.eval(l)
+	for _, v := range n.list.eval(l).([]interface{}) {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func compare[T float64 | string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// parser is a recursive descent parser of the grammar
+//
+//	or         = and { "||" and }
+//	and        = unary { "&&" unary }
+//	unary      = "!" unary | comparison
+//	comparison = operand [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" ) operand | "in" operand ]
+//	operand    = ident | number | string | "true" | "false" | list | "(" or ")"
+//	list       = "[" [ literal { "," literal } ] "]"
+type parser struct {
+	tokens []token
+	pos    int
+	vars   Vars
+}
+
+func newParser(source string, vars Vars) (*parser, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+	return &parser{tokens: tokens, vars: vars}, nil
+}
+
+func (p *parser) parse() (node, error) {
+	start := p.peek()
+	if start.typ == tokenEOF {
+		return nil, p.errorf(start, "empty expression")
+	}
+	root, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.typ != tokenEOF {
+		return nil, p.errorf(tok, "unexpected token")
+	}
+	if root.kind() != Bool {
+		return nil, p.errorf(start, "expression is a %s, not a condition", root.kind())
+	}
+	return root, nil
+}
+
+func (p *parser) or() (node, error) {
+	return p.logical(tokenOr, p.and)
+}
+
+func (p *parser) and() (node, error) {
+	return p.logical(tokenAnd, p.unary)
+}
+
+// logical parses a left associative chain of operands joined by op.
+func (p *parser) logical(op tokenType, operand func() (node, error)) (node, error) {
+	start := p.peek()
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().typ == op {
+		tok := p.next()
+		if left.kind() != Bool {
+			return nil, p.errorf(start, "%s operand is a %s, not a condition", tok.text, left.kind())
+		}
+		rightStart := p.peek()
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		if right.kind() != Bool {
+			return nil, p.errorf(rightStart, "%s operand is a %s, not a condition", tok.text, right.kind())
+		}
+		left = logical{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) unary() (node, error) {
+	if p.peek().typ != tokenNot {
+		return p.comparison()
+	}
+	p.next()
+	start := p.peek()
+	operand, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	if operand.kind() != Bool {
+		return nil, p.errorf(start, "! operand is a %s, not a condition", operand.kind())
+	}
+	return not{operand: operand}, nil
+}
+
+func (p *parser) comparison() (node, error) {
+	left, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+
+	tok := p.peek()
+	switch tok.typ {
+	case tokenEq, tokenNe, tokenLt, tokenLe, tokenGt, tokenGe:
+		p.next()
+		rightStart := p.peek()
+		right, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+		if left.kind() == List || right.kind() == List {
+			return nil, p.errorf(tok, "cannot compare lists with %s", tok.text)
+		}
+		if left.kind() != right.kind() {
+			return nil, p.errorf(rightStart, "cannot compare %s with %s", left.kind(), right.kind())
+		}
+		if left.kind() == Bool && tok.typ != tokenEq && tok.typ != tokenNe {
+			return nil, p.errorf(tok, "cannot order booleans with %s", tok.text)
+		}
+		return comparison{op: tok.typ, left: left, right: right}, nil
+	case tokenIn:
+		p.next()
+		rightStart := p.peek()
+		right, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+		l, ok := right.(list)
+		if !ok {
+			return nil, p.errorf(rightStart, "in expects a list")
+		}
+		if len(l.values) > 0 && l.elem != left.kind() {
+			return nil, p.errorf(rightStart, "cannot look up a %s in a list of %ss", left.kind(), l.elem)
+		}
+		return membership{value: left, list: right}, nil
+	}
+	return left, nil
+}
+
+func (p *parser) operand() (node, error) {
+	tok := p.next()
+	switch tok.typ {
+	case tokenIdent:
+		k, ok := p.vars[tok.text]
+		if !ok {
+			return nil, p.errorf(tok, "unknown field")
+		}
+		return variable{k: k, name: tok.text}, nil
+	case tokenNumber:
+		return literal{k: Number, value: tok.value}, nil
+	case tokenString:
+		return literal{k: String, value: tok.value}, nil
+	case tokenTrue, tokenFalse:
+		return literal{k: Bool, value: tok.typ == tokenTrue}, nil
+	case tokenLBracket:
+		return p.list(tok)
+	case tokenLParen:
+		inner, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.typ != tokenRParen {
+			return nil, p.errorf(closing, "expected )")
+		}
+		return inner, nil
+	case tokenEOF:
+		return nil, p.errorf(tok, "expected a value")
+	default:
+		return nil, p.errorf(tok, "unexpected token")
+	}
+}
+
+func (p *parser) list(open token) (node, error) {
+	l := list{values: make([]interface{}, 0)}
+	if p.peek().typ == tokenRBracket {
+		p.next()
+		return l, nil
+	}
+	for {
+		tok := p.next()
+		var k Kind
+		switch tok.typ {
+		case tokenNumber:
+			k = Number
+		case tokenString:
+			k = String
+		case tokenEOF:
+			return nil, p.errorf(open, "unterminated list")
+		default:
+			return nil, p.errorf(tok, "list elements must be numbers or strings")
+		}
+		if len(l.values) > 0 && k != l.elem {
+			return nil, p.errorf(tok, "list mixes %ss and %ss", l.elem, k)
+		}
+		l.elem = k
+		l.values = append(l.values, tok.value)
+
+		switch sep := p.next(); sep.typ {
+		case tokenComma:
+		case tokenRBracket:
+			return l, nil
+		case tokenEOF:
+			return nil, p.errorf(open, "unterminated list")
+		default:
+			return nil, p.errorf(sep, "expected , or ]")
+		}
+	}
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.typ != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) errorf(tok token, format string, args ...interface{}) error {
+	return &Error{Pos: tok.pos, Token: tok.text, Msg: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,97 @@
+package indicators
+
+import (
+	"math"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/filter"
+)
+
+// ConditionVars are the indicator values an indicator condition may reference, computed
+// with the default periods over the candles of one pair.
+var ConditionVars = filter.Vars{
+	"close":       filter.Number,
+	"sma":         filter.Number,
+	"ema":         filter.Number,
+	"rsi":         filter.Number,
+	"macd":        filter.Number,
+	"macd_signal": filter.Number,
+	"macd_hist":   filter.Number,
+	"bb_upper":    filter.Number,
+	"bb_middle":   filter.Number,
+	"bb_lower":    filter.Number,
+	"atr":         filter.Number,
+	"vwap":        filter.Number,
+}
+
+// conditionSpecs are the indicators of the ConditionVars, with their default periods.
+var conditionSpecs = []Spec{
+	{Name: NameSMA, Period: defaultPeriods[NameSMA]},
+	{Name: NameEMA, Period: defaultPeriods[NameEMA]},
+	{Name: NameRSI, Period: defaultPeriods[NameRSI]},
+	{Name: NameMACD},
+	{Name: NameBollinger, Period: defaultPeriods[NameBollinger]},
+	{Name: NameATR, Period: defaultPeriods[NameATR]},
+	{Name: NameVWAP, Period: defaultPeriods[NameVWAP]},
+}
+
+// ParseCondition parses an indicator condition over the ConditionVars, e.g.
+// `rsi < 70 && close > sma`, in the expression language of the filter package.
+func ParseCondition(expression string) (*filter.Program, error) {
+	return filter.Parse(expression, ConditionVars)
+}
+
+// ConditionLookback returns the number of candles a condition is evaluated over.
+func ConditionLookback() int {
+	return Lookback(conditionSpecs)
+}
+
+// Lookup returns the ConditionVars values of the latest candle. It reports false when
+// there are fewer candles than ConditionLookback, since some values would be undefined.
+func Lookup(candles []common.Candle) (filter.Lookup, bool) {
+	if len(candles) < ConditionLookback() {
+		return nil, false
+	}
+
+	closes := Closes(candles)
+	macd, signal, histogram := MACD(closes, macdFast, macdSlow, macdSignal)
+	middle, upper, lower := Bollinger(closes, defaultPeriods[NameBollinger], bollingerWidth)
+	values := map[string][]float64{
+		"close":       closes,
+		"sma":         SMA(closes, defaultPeriods[NameSMA]),
+		"ema":         EMA(closes, defaultPeriods[NameEMA]),
+		"rsi":         RSI(closes, defaultPeriods[NameRSI]),
+		"macd":        macd,
+		"macd_signal": signal,
+		"macd_hist":   histogram,
+		"bb_upper":    upper,
+		"bb_middle":   middle,
+		"bb_lower":    lower,
+		"atr":         ATR(candles, defaultPeriods[NameATR]),
+		"vwap":        VWAP(candles, defaultPeriods[NameVWAP]),
+	}
+	return func(name string) interface{} {
+		if v := last(values[name]); v != nil {
+			return *v
+		}
+		return math.NaN()
+	}, true
+}
+
+// CandleFetcher returns the latest candles of a trading pair, oldest first.
+type CandleFetcher func(symbol string) ([]common.Candle, error)
+
+// Checker returns a check for common.GainersQuery that keeps the tickers whose candles,
+// fetched with at most concurrency requests in flight, match the condition. Tickers
+// with too few candles are dropped. A fetch error drops its ticker and is reported by
+// the returned error function once the ranking is done; see common.CheckEach.
+func Checker(fetch CandleFetcher, condition *filter.Program, concurrency int) (func([]common.Ticker) []bool, func() error) {
+	return common.CheckEach(concurrency, func(t common.Ticker) (bool, error) {
+		candles, err := fetch(t.Symbol)
+		if err != nil {
+			return false, err
+		}
+		lookup, ok := Lookup(candles)
+		return ok && condition.Match(lookup), nil
+	})
+}
@@ -0,0 +1,194 @@
+// Package indicators computes technical indicators over candles: moving averages (SMA,
+// EMA), RSI, MACD, Bollinger Bands, ATR and a rolling VWAP.
+//
+// Every indicator returns a series aligned with its input. Entries before the indicator
+// has enough data are NaN, so the latest value of a series is defined once the input is
+// at least as long as its warm-up; see Lookback.
+package indicators
+
+import (
+	"math"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// Closes returns the close prices of the candles.
+func Closes(candles []common.Candle) []float64 {
+	closes := make([]float64, len(candles))
+	for i, candle := range candles {
+		closes[i] = candle.Close
+	}
+	return closes
+}
+
+// SMA returns the simple moving average of the values over period.
+func SMA(values []float64, period int) []float64 {
+	out := nans(len(values))
+	if period <= 0 {
+		return out
+	}
+	sum := 0.0
+	for i, v := range values {
+		sum += v
+		if i >= period {
+			sum -= values[i-period]
+		}
+		if i >= period-1 {
+			out[i] = sum / float64(period)
+		}
+	}
+	return out
+}
+
+// EMA returns the exponential moving average of the values over period, seeded with the
+// SMA of the first period values. Leading NaN values, e.g. of another indicator's warm-up,
+// are skipped.
+func EMA(values []float64, period int) []float64 {
+	out := nans(len(values))
+	start := 0
+	for start < len(values) && math.IsNaN(values[start]) {
+		start++
+	}
+	if period <= 0 || len(values)-start < period {
+		return out
+	}
+
+	alpha := 2 / float64(period+1)
+	seed := start + period - 1
+	out[seed] = SMA(values[start:seed+1], period)[period-1]
+	for i := seed + 1; i < len(values); i++ {
+		out[i] = alpha*values[i] + (1-alpha)*out[i-1]
+	}
+	return out
+}
+
+// RSI returns Wilder's relative strength index of the values over period, from 0 to 100.
+func RSI(values []float64, period int) []float64 {
+	out := nans(len(values))
+	if period <= 0 || len(values) <= period {
+		return out
+	}
+
+	var gain, loss float64
+	for i := 1; i <= period; i++ {
+		change := values[i] - values[i-1]
+		gain += max(change, 0)
+		loss += max(-change, 0)
+	}
+	gain /= float64(period)
+	loss /= float64(period)
+	out[period] = rsi(gain, loss)
+
+	for i := period + 1; i < len(values); i++ {
+		change := values[i] - values[i-1]
+		gain = (gain*float64(period-1) + max(change, 0)) / float64(period)
+		loss = (loss*float64(period-1) + max(-change, 0)) / float64(period)
+		out[i] = rsi(gain, loss)
+	}
+	return out
+}
+
+// rsi returns the relative strength index of an average gain and loss. A series without
+// losses scores 100, and a flat series 50.
+func rsi(gain, loss float64) float64 {
+	if loss == 0 {
+		if gain == 0 {
+			return 50
+		}
+		return 100
+	}
+	return 100 - 100/(1+gain/loss)
+}
+
+// MACD returns the MACD line, the EMA over fast minus the EMA over slow periods, its
+// signal line, the EMA of the MACD line over signal periods, and their difference.
+func MACD(values []float64, fast, slow, signal int) (macd, signalLine, histogram []float64) {
+	fastEMA, slowEMA := EMA(values, fast), EMA(values, slow)
+	macd = make([]float64, len(values))
+	for i := range values {
+		macd[i] = fastEMA[i] - slowEMA[i]
+	}
+	signalLine = EMA(macd, signal)
+	histogram = make([]float64, len(values))
+	for i := range values {
+		histogram[i] = macd[i] - signalLine[i]
+	}
+	return macd, signalLine, histogram
+}
+
+// Bollinger returns the Bollinger Bands of the values: the SMA over period and the bands
+// k population standard deviations above and below it.
+func Bollinger(values []float64, period int, k float64) (middle, upper, lower []float64) {
+	middle = SMA(values, period)
+	upper, lower = nans(len(values)), nans(len(values))
+	for i := range values {
+		if math.IsNaN(middle[i]) {
+			continue
+		}
+		variance := 0.0
+		for _, v := range values[i-period+1 : i+1] {
+			variance += (v - middle[i]) * (v - middle[i])
+		}
+		deviation := math.Sqrt(variance / float64(period))
+		upper[i] = middle[i] + k*deviation
+		lower[i] = middle[i] - k*deviation
+	}
+	return middle, upper, lower
+}
+
+// ATR returns Wilder's average true range of the candles over period.
+func ATR(candles []common.Candle, period int) []float64 {
+	out := nans(len(candles))
+	if period <= 0 || len(candles) < period {
+		return out
+	}
+
+	ranges := make([]float64, len(candles))
+	for i, candle := range candles {
+		ranges[i] = candle.High - candle.Low
+		if i > 0 {
+			previous := candles[i-1].Close
+			ranges[i] = max(ranges[i], math.Abs(candle.High-previous), math.Abs(candle.Low-previous))
+		}
+	}
+
+	atr := SMA(ranges[:period], period)[period-1]
+	out[period-1] = atr
+	for i := period; i < len(candles); i++ {
+		atr = (atr*float64(period-1) + ranges[i]) / float64(period)
+		out[i] = atr
+	}
+	return out
+}
+
+// VWAP returns the volume-weighted average typical price, (high+low+close)/3, of the
+// candles over a rolling window of period candles. Windows without volume are NaN.
+func VWAP(candles []common.Candle, period int) []float64 {
+	out := nans(len(candles))
+	if period <= 0 {
+		return out
+	}
+	var value, volume float64
+	for i, candle := range candles {
+		value += (candle.High + candle.Low + candle.Close) / 3 * candle.Volume
+		volume += candle.Volume
+		if i >= period {
+			old := candles[i-period]
+			value -= (old.High + old.Low + old.Close) / 3 * old.Volume
+			volume -= old.Volume
+		}
+		if i >= period-1 && volume > 0 {
+			out[i] = value / volume
+		}
+	}
+	return out
+}
+
+// nans returns a series of n NaN values.
+func nans(n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = math.NaN()
+	}
+	return out
+}
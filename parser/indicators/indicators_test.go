@@ -0,0 +1,150 @@
+package indicators
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// equal reports whether two series are equal within rounding, NaN matching NaN.
+func equal(got, want []float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if math.IsNaN(want[i]) != math.IsNaN(got[i]) || !math.IsNaN(want[i]) && math.Abs(got[i]-want[i]) > 1e-9 {
+			return false
+		}
+	}
+	return true
+}
+
+// candles returns n hourly candles closing at the given prices, two apart from high to low.
+func candles(closes ...float64) []common.Candle {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	out := make([]common.Candle, len(closes))
+	for i, c := range closes {
+		out[i] = common.Candle{OpenTime: start.Add(time.Duration(i) * time.Hour), Open: c, High: c + 1, Low: c - 1, Close: c, Volume: 1}
+	}
+	return out
+}
+
+func TestIndicators(t *testing.T) {
+	nan := math.NaN()
+	middle, upper, lower := Bollinger([]float64{1, 2, 3}, 3, 2)
+	macd, signal, histogram := MACD(make([]float64, 40), 12, 26, 9)
+
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{"sma", SMA([]float64{1, 2, 3, 4, 5}, 3), []float64{nan, nan, 2, 3, 4}},
+		{"ema", EMA([]float64{1, 2, 3, 4, 5}, 3), []float64{nan, nan, 2, 3, 4}},
+		{"ema after nan", EMA([]float64{nan, 2, 4, 8}, 2), []float64{nan, nan, 3, 19.0 / 3}},
+		{"rsi", RSI([]float64{1, 2, 1, 2, 1}, 2), []float64{nan, nan, 50, 75, 37.5}},
+		{"rsi rising", RSI([]float64{1, 2, 3, 4}, 2), []float64{nan, nan, 100, 100}},
+		{"bollinger middle", middle, []float64{nan, nan, 2}},
+		{"bollinger upper", upper, []float64{nan, nan, 2 + 2*math.Sqrt(2.0/3)}},
+		{"bollinger lower", lower, []float64{nan, nan, 2 - 2*math.Sqrt(2.0/3)}},
+		{"atr", ATR(candles(10, 10, 10, 10), 2), []float64{nan, 2, 2, 2}},
+		{"atr gap", ATR(candles(10, 14), 1), []float64{2, 5}},
+		{"vwap", VWAP([]common.Candle{{High: 10, Low: 10, Close: 10, Volume: 1}, {High: 20, Low: 20, Close: 20, Volume: 3}}, 2), []float64{nan, 17.5}},
+		{"macd", macd[len(macd)-1:], []float64{0}},
+		{"macd signal", signal[32:34], []float64{nan, 0}},
+		{"macd histogram", histogram[33:34], []float64{0}},
+	}
+
+	for _, tt := range tests {
+		if !equal(tt.got, tt.want) {
+			t.Errorf("%s: expected %v, but got %v", tt.name, tt.want, tt.got)
+		}
+	}
+}
+
+func TestParseSet(t *testing.T) {
+	specs, err := ParseSet("RSI, macd,sma:50,rsi")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	want := []string{"rsi", "macd", "sma:50"}
+	if len(specs) != len(want) {
+		t.Fatalf("Expected %v, but got %v", want, specs)
+	}
+	for i, spec := range specs {
+		if spec.String() != want[i] {
+			t.Errorf("Expected %s, but got %s", want[i], spec)
+		}
+	}
+	if lookback := Lookback(specs); lookback != 4*26+9 {
+		t.Errorf("Expected a lookback of %d, but got %d", 4*26+9, lookback)
+	}
+
+	for _, set := range []string{"", "foo", "macd:5", "sma:0", "rsi:x"} {
+		if _, err := ParseSet(set); err == nil {
+			t.Errorf("Expected an error for %q", set)
+		}
+	}
+}
+
+func TestLatest(t *testing.T) {
+	specs, _ := ParseSet("sma:3,rsi,bollinger")
+	values := Latest(candles(1, 2, 3, 4), specs)
+
+	if sma, ok := values["sma:3"].(*float64); !ok || sma == nil || *sma != 3 {
+		t.Errorf("Expected sma:3 of 3, but got %v", values["sma:3"])
+	}
+	if rsi, ok := values["rsi"].(*float64); !ok || rsi != nil {
+		t.Errorf("Expected no rsi over 4 candles, but got %v", values["rsi"])
+	}
+	if bands, ok := values["bollinger"].(BandsValue); !ok || bands.Middle != nil {
+		t.Errorf("Expected no bollinger bands over 4 candles, but got %v", values["bollinger"])
+	}
+}
+
+func TestChecker(t *testing.T) {
+	rising := make([]float64, ConditionLookback())
+	for i := range rising {
+		rising[i] = float64(100 + i)
+	}
+	history := map[string][]common.Candle{
+		"BTCUSDT": candles(rising...),
+		"NEWUSDT": candles(rising[:10]...),
+	}
+	fetch := func(symbol string) ([]common.Candle, error) {
+		if candles, ok := history[symbol]; ok {
+			return candles, nil
+		}
+		return nil, errors.New("no klines")
+	}
+	tickers := []common.Ticker{{Symbol: "BTCUSDT"}, {Symbol: "NEWUSDT"}, {Symbol: "FOOUSDT"}}
+
+	tests := []struct {
+		condition string
+		want      []bool
+	}{
+		{"rsi > 70 && close > sma && macd > 0", []bool{true, false, false}},
+		{"rsi < 70", []bool{false, false, false}},
+	}
+
+	for _, tt := range tests {
+		condition, err := ParseCondition(tt.condition)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		check, failed := Checker(fetch, condition, 2)
+		got := check(tickers)
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: expected %v, but got %v", tt.condition, tt.want, got)
+				break
+			}
+		}
+		if err := failed(); err == nil {
+			t.Errorf("%s: expected the fetch error of FOOUSDT", tt.condition)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package indicators
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// Indicator names accepted in a set.
+const (
+	NameSMA       = "sma"
+	NameEMA       = "ema"
+	NameRSI       = "rsi"
+	NameMACD      = "macd"
+	NameBollinger = "bollinger"
+	NameATR       = "atr"
+	NameVWAP      = "vwap"
+)
+
+// Default periods of the indicators. MACD uses the 12, 26 and 9 periods and Bollinger
+// Bands are two standard deviations wide.
+var defaultPeriods = map[string]int{
+	NameSMA:       20,
+	NameEMA:       20,
+	NameRSI:       14,
+	NameMACD:      0,
+	NameBollinger: 20,
+	NameATR:       14,
+	NameVWAP:      20,
+}
+
+const (
+	macdFast          = 12
+	macdSlow          = 26
+	macdSignal        = 9
+	bollingerWidth    = 2
+	maxPeriod         = 1000
+	smoothingLookback = 4
+)
+
+// Spec selects an indicator and its period.
+type Spec struct {
+	Name   string
+	Period int
+}
+
+// String returns the name of the indicator, followed by its period when it is not the
+// default, e.g. "rsi" or "sma:50".
+func (s Spec) String() string {
+	if s.Period == defaultPeriods[s.Name] {
+		return s.Name
+	}
+	return s.Name + ":" + strconv.Itoa(s.Period)
+}
+
+// ParseSet parses a comma-separated set of indicators, each optionally followed by a
+// period, e.g. "rsi,macd,sma:50". Duplicates are dropped.
+func ParseSet(set string) ([]Spec, error) {
+	var specs []Spec
+	seen := make(map[Spec]bool)
+	for _, item := range strings.Split(set, ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item == "" {
+			continue
+		}
+		name, period, hasPeriod := strings.Cut(item, ":")
+		if _, ok := defaultPeriods[name]; !ok {
+			return nil, fmt.Errorf("unknown indicator: %s", name)
+		}
+		spec := Spec{Name: name, Period: defaultPeriods[name]}
+		if hasPeriod {
+			p, err := strconv.Atoi(period)
+			if name == NameMACD || err != nil || p <= 0 || p > maxPeriod {
+				return nil, fmt.Errorf("invalid period of %s: %s", name, period)
+			}
+			spec.Period = p
+		}
+		if !seen[spec] {
+			seen[spec] = true
+			specs = append(specs, spec)
+		}
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("no indicators")
+	}
+	return specs, nil
+}
+
+// Lookback returns the number of candles the indicators need for their latest values.
+// Smoothed indicators (EMA, RSI, MACD, ATR) get several periods to converge.
+func Lookback(specs []Spec) int {
+	lookback := 1
+	for _, spec := range specs {
+		n := spec.Period
+		switch spec.Name {
+		case NameEMA, NameRSI, NameATR:
+			n = smoothingLookback*spec.Period + 1
+		case NameMACD:
+			n = smoothingLookback*macdSlow + macdSignal
+		}
+		lookback = max(lookback, n)
+	}
+	return lookback
+}
+
+// MACDValue is the latest value of the MACD indicator.
+type MACDValue struct {
+	MACD      *float64 `json:"macd"`
+	Signal    *float64 `json:"signal"`
+	Histogram *float64 `json:"histogram"`
+}
+
+// BandsValue is the latest value of the Bollinger Bands.
+type BandsValue struct {
+	Upper  *float64 `json:"upper"`
+	Middle *float64 `json:"middle"`
+	Lower  *float64 `json:"lower"`
+}
+
+// Latest returns the latest value of every indicator of the set over the candles, keyed
+// by the String of its Spec. Values are a *float64, or a MACDValue or BandsValue; values
+// the candles are too few for are nil.
+func Latest(candles []common.Candle, specs []Spec) map[string]interface{} {
+	closes := Closes(candles)
+	values := make(map[string]interface{}, len(specs))
+	for _, spec := range specs {
+		var value interface{}
+		switch spec.Name {
+		case NameSMA:
+			value = last(SMA(closes, spec.Period))
+		case NameEMA:
+			value = last(EMA(closes, spec.Period))
+		case NameRSI:
+			value = last(RSI(closes, spec.Period))
+		case NameMACD:
+			macd, signal, histogram := MACD(closes, macdFast, macdSlow, macdSignal)
+			value = MACDValue{MACD: last(macd), Signal: last(signal), Histogram: last(histogram)}
+		case NameBollinger:
+			middle, upper, lower := Bollinger(closes, spec.Period, bollingerWidth)
+			value = BandsValue{Upper: last(upper), Middle: last(middle), Lower: last(lower)}
+		case NameATR:
+			value = last(ATR(candles, spec.Period))
+		case NameVWAP:
+			value = last(VWAP(candles, spec.Period))
+		}
+		values[spec.String()] = value
+	}
+	return values
+}
+
+// last returns the last value of the series, or nil when it is empty or NaN.
+func last(series []float64) *float64 {
+	if len(series) == 0 || math.IsNaN(series[len(series)-1]) {
+		return nil
+	}
+	v := series[len(series)-1]
+	return &v
+}
+
+// Snapshot is the latest value of a set of indicators of a trading pair.
+type Snapshot struct {
+	Symbol   string                 `json:"symbol"`
+	Interval common.Interval        `json:"interval"`
+	OpenTime time.Time              `json:"open_time"`
+	Close    float64                `json:"close"`
+	Values   map[string]interface{} `json:"indicators"`
+}
+
+// NewSnapshot returns the latest values of the indicators over the candles of a trading
+// pair, as of the last candle; see Latest.
+func NewSnapshot(symbol string, interval common.Interval, candles []common.Candle, specs []Spec) Snapshot {
+	snapshot := Snapshot{Symbol: symbol, Interval: interval, Values: Latest(candles, specs)}
+	if len(candles) > 0 {
+		snapshot.OpenTime = candles[len(candles)-1].OpenTime
+		snapshot.Close = candles[len(candles)-1].Close
+	}
+	return snapshot
+}
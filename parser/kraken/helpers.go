@@ -0,0 +1,115 @@
+package kraken
+
+import (
+	"math"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// legacyAssets maps Kraken's legacy asset codes to their common names.
+var legacyAssets = map[string]string{
+	"XXBT": "BTC", "XBT": "BTC",
+	"XXDG": "DOGE", "XDG": "DOGE",
+	"XETH": "ETH", "XETC": "ETC", "XLTC": "LTC", "XMLN": "MLN", "XREP": "REP",
+	"XXLM": "XLM", "XXMR": "XMR", "XXRP": "XRP", "XZEC": "ZEC",
+	"ZUSD": "USD", "ZEUR": "EUR", "ZGBP": "GBP", "ZJPY": "JPY", "ZCAD": "CAD", "ZAUD": "AUD", "ZCHF": "CHF",
+}
+
+// NormalizeAsset returns the common name of a Kraken asset code, e.g. XXBT becomes BTC.
+func NormalizeAsset(code string) string {
+	if name, ok := legacyAssets[code]; ok {
+		return name
+	}
+	return code
+}
+
+// splitPair splits a pair name into normalized base and quote assets without metadata.
+// Legacy names such as XXBTZUSD are split into their two four-letter asset codes.
+func splitPair(symbol string) (base, quote string) {
+	if len(symbol) == 8 {
+		if b, ok := legacyAssets[symbol[:4]]; ok {
+			if q, ok := legacyAssets[symbol[4:]]; ok {
+				return b, q
+			}
+		}
+	}
+	base, quote = common.SplitSymbol(symbol)
+	return NormalizeAsset(base), NormalizeAsset(quote)
+}
+
+// toTickerData unfolds the packed ticker of a pair. Kraken has no rolling 24-hour open,
+// so the change is computed from today's opening price.
+func (r rawTicker) toTickerData(symbol string) TickerData {
+	t := TickerData{
+		Symbol:           symbol,
+		AskPrice:         at(r.A, 0),
+		AskLotVolume:     at(r.A, 2),
+		BidPrice:         at(r.B, 0),
+		BidLotVolume:     at(r.B, 2),
+		LastPrice:        at(r.C, 0),
+		LastLotVolume:    at(r.C, 1),
+		Volume24h:        at(r.V, 1),
+		WeightedAvgPrice: at(r.P, 1),
+		LowPrice24h:      at(r.L, 1),
+		HighPrice24h:     at(r.H, 1),
+		OpenPrice:        r.O,
+	}
+	if len(r.T) > 1 {
+		t.TradeCount = r.T[1]
+	}
+	t.ChangePercent = common.ChangePercent(common.ParseFloat(t.OpenPrice), common.ParseFloat(t.LastPrice))
+	return t
+}
+
+// at returns the i-th element of a packed field, or an empty string when it is missing.
+func at(values []string, i int) string {
+	if i < len(values) {
+		return values[i]
+	}
+	return ""
+}
+
+// ToTicker converts the Kraken ticker to the exchange-agnostic Ticker. The quote volume is
+// estimated from the 24-hour volume weighted average price. The open price is today's
+// opening price, so the change covers the window since UTC midnight rather than the last
+// 24 hours, and OpenTime is set to that midnight.
+func (t TickerData) ToTicker() common.Ticker {
+	base, quote := splitPair(t.Symbol)
+	volume := common.ParseFloat(t.Volume24h)
+	now := time.Now().UTC()
+	return common.Ticker{
+		Exchange:      Name,
+		Market:        common.SpotMarket,
+		Symbol:        t.Symbol,
+		BaseAsset:     base,
+		QuoteAsset:    quote,
+		LastPrice:     common.ParseFloat(t.LastPrice),
+		OpenPrice:     common.ParseFloat(t.OpenPrice),
+		HighPrice:     common.ParseFloat(t.HighPrice24h),
+		LowPrice:      common.ParseFloat(t.LowPrice24h),
+		BidPrice:      common.ParseFloat(t.BidPrice),
+		BidQuantity:   common.ParseFloat(t.BidLotVolume),
+		AskPrice:      common.ParseFloat(t.AskPrice),
+		AskQuantity:   common.ParseFloat(t.AskLotVolume),
+		Volume:        volume,
+		QuoteVolume:   volume * common.ParseFloat(t.WeightedAvgPrice),
+		ChangePercent: t.ChangePercent,
+		TradeCount:    t.TradeCount,
+		OpenTime:      now.Truncate(24 * time.Hour),
+		CloseTime:     now,
+	}
+}
+
+// ToSymbolInfo converts the asset pair to the exchange-agnostic SymbolInfo with normalized asset names.
+func (p AssetPair) ToSymbolInfo(symbol string) common.SymbolInfo {
+	return common.SymbolInfo{
+		Symbol:     symbol,
+		BaseAsset:  NormalizeAsset(p.Base),
+		QuoteAsset: NormalizeAsset(p.Quote),
+		Status:     p.Status,
+		Trading:    p.Status == "online",
+		TickSize:   common.ParseFloat(p.TickSize),
+		LotSize:    math.Pow10(-p.LotDecimals),
+	}
+}
@@ -0,0 +1,199 @@
+package kraken
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+const baseURL = "https://api.kraken.com"
+
+// symbolsTTL is how long AssetPairs metadata is cached.
+const symbolsTTL = time.Hour
+
+// PairListResponse is the Freqtrade-style pair list returned by GetTickersGainerForPairs.
+type PairListResponse = common.PairListResponse
+
+// Client is a struct representing the Kraken API client.
+type Client struct {
+	apiKey    string
+	apiSecret string
+	baseURL   string
+	client    *http.Client
+	symbols   *common.SymbolCache
+}
+
+// NewClient creates a new instance of the Kraken API client.
+func NewClient(apiKey, apiSecret string) *Client {
+	return &Client{
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+		baseURL:   baseURL,
+		client:    &http.Client{},
+		symbols:   common.NewSymbolCache(symbolsTTL),
+	}
+}
+
+// Get24HourTickerData returns ticker data for all trading pairs, sorted by pair name.
+func (c *Client) Get24HourTickerData() ([]TickerData, error) {
+	var raw map[string]rawTicker
+	if err := c.get("/0/public/Ticker", nil, &raw); err != nil {
+		return nil, err
+	}
+	return unpackTickers(raw), nil
+}
+
+// GetTickerForPair returns ticker data for a single trading pair. The pair may be given
+// as Kraken's pair name (XXBTZUSD), its altname (XBTUSD) or with common asset names (BTCUSD).
+func (c *Client) GetTickerForPair(pairSymbol string) (TickerData, error) {
+	query := url.Values{}
+	query.Set("pair", c.resolvePair(pairSymbol))
+
+	var raw map[string]rawTicker
+	if err := c.get("/0/public/Ticker", query, &raw); err != nil {
+		if strings.Contains(err.Error(), "Unknown asset pair") {
+			return TickerData{}, fmt.Errorf("%w: %s", common.ErrUnknownSymbol, pairSymbol)
+		}
+		return TickerData{}, err
+	}
+	tickers := unpackTickers(raw)
+	if len(tickers) == 0 {
+		return TickerData{}, fmt.Errorf("%w: %s", common.ErrUnknownSymbol, pairSymbol)
+	}
+	return tickers[0], nil
+}
+
+// Get24HourGainersTickerData returns all trading pairs with a positive price change
+// since today's open, sorted by performance (descending order).
+func (c *Client) Get24HourGainersTickerData(limit int, endingFilter string) ([]TickerData, error) {
+	return c.rank(common.RankingGainers, common.GainersQuery{Limit: limit, EndingFilter: endingFilter})
+}
+
+// GetTickersGainerForPairs returns the top gainers formatted as "BASE/QUOTE" pairs,
+// e.g. BTC/USD for XXBTZUSD.
+func (c *Client) GetTickersGainerForPairs(limit int, endingFilter, excludeFilter string) (PairListResponse, error) {
+	query := common.GainersQuery{Limit: limit, EndingFilter: endingFilter, ExcludeFilter: excludeFilter}
+	return c.rankPairs(common.RankingGainers, query)
+}
+
+// GetAssetPairs returns the metadata of every tradable asset pair keyed by pair name.
+// Dark pool pairs are skipped.
+func (c *Client) GetAssetPairs() (map[string]AssetPair, error) {
+	var pairs map[string]AssetPair
+	if err := c.get("/0/public/AssetPairs", nil, &pairs); err != nil {
+		return nil, err
+	}
+	for name, pair := range pairs {
+		if strings.HasSuffix(pair.Altname, ".d") {
+			delete(pairs, name)
+		}
+	}
+	return pairs, nil
+}
+
+// rank ranks the normalized tickers and returns the matching native tickers.
+func (c *Client) rank(ranking common.Ranking, query common.GainersQuery) ([]TickerData, error) {
+	data, err := c.Get24HourTickerData()
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := common.Rank(c.toTickers(data), ranking, query)
+	return common.Pick(data, func(t TickerData) string { return t.Symbol }, ranked), nil
+}
+
+// rankPairs ranks the normalized tickers and formats them as "BASE/QUOTE" pairs.
+func (c *Client) rankPairs(ranking common.Ranking, query common.GainersQuery) (PairListResponse, error) {
+	tickers, err := c.normalizedTickers()
+	if err != nil {
+		return PairListResponse{}, err
+	}
+	return common.PairList(common.Rank(tickers, ranking, query)), nil
+}
+
+// normalizedTickers returns the tickers converted to the exchange-agnostic Ticker.
+func (c *Client) normalizedTickers() ([]common.Ticker, error) {
+	data, err := c.Get24HourTickerData()
+	if err != nil {
+		return nil, err
+	}
+	return c.toTickers(data), nil
+}
+
+// toTickers converts native tickers and enriches them with the AssetPairs metadata.
+func (c *Client) toTickers(data []TickerData) []common.Ticker {
+	tickers := make([]common.Ticker, 0, len(data))
+	for _, t := range data {
+		tickers = append(tickers, t.ToTicker())
+	}
+	c.symbolsOrEmpty().Enrich(tickers)
+	return tickers
+}
+
+// resolvePair maps a common-name symbol such as BTCUSD to Kraken's pair name using the
+// metadata. Other symbols, including altnames which Kraken resolves itself, are returned unchanged.
+func (c *Client) resolvePair(symbol string) string {
+	symbols := c.symbolsOrEmpty()
+	if _, ok := symbols[symbol]; ok {
+		return symbol
+	}
+	for name, info := range symbols {
+		if info.BaseAsset+info.QuoteAsset == symbol {
+			return name
+		}
+	}
+	return symbol
+}
+
+// get performs a GET request against the Kraken API and decodes the result of the response into out.
+func (c *Client) get(path string, query url.Values, out interface{}) error {
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("creating request failed: %v", err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("executing request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("received non-OK response status: %s", res.Status)
+	}
+
+	var response Response
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("decoding response failed: %v", err)
+	}
+	if len(response.Error) > 0 {
+		return fmt.Errorf("kraken error: %s", strings.Join(response.Error, ", "))
+	}
+	if err := json.Unmarshal(response.Result, out); err != nil {
+		return fmt.Errorf("decoding response result failed: %v", err)
+	}
+	return nil
+}
+
+// unpackTickers unfolds the packed tickers keyed by pair name, sorted by pair name.
+func unpackTickers(raw map[string]rawTicker) []TickerData {
+	tickers := make([]TickerData, 0, len(raw))
+	for symbol, r := range raw {
+		tickers = append(tickers, r.toTickerData(symbol))
+	}
+	sort.Slice(tickers, func(i, j int) bool {
+		return tickers[i].Symbol < tickers[j].Symbol
+	})
+	return tickers
+}
@@ -0,0 +1,84 @@
+package kraken
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var jsonResponse string
+		switch r.URL.Path {
+		case "/0/public/Ticker":
+			jsonResponse = `{"error":[],"result":{
+				"XXBTZUSD":{"a":["105.1","1","1.000"],"b":["104.9","1","1.000"],"c":["105.0","0.1"],"v":["5","10"],"p":["104","103"],"t":[50,100],"l":["99","99"],"h":["106","106"],"o":"100.0"},
+				"XETHZEUR":{"a":["110.1","1","1.000"],"b":["109.9","1","1.000"],"c":["110.0","0.1"],"v":["2","4"],"p":["108","107"],"t":[20,40],"l":["98","98"],"h":["111","111"],"o":"100.0"},
+				"SOLUSD":{"a":["90.1","1","1.000"],"b":["89.9","1","1.000"],"c":["90.0","0.1"],"v":["1","2"],"p":["95","95"],"t":[5,10],"l":["89","89"],"h":["101","101"],"o":"100.0"}
+			}}`
+		case "/0/public/AssetPairs":
+			jsonResponse = `{"error":[],"result":{
+				"XXBTZUSD":{"altname":"XBTUSD","wsname":"XBT/USD","base":"XXBT","quote":"ZUSD","tick_size":"0.1","lot_decimals":8,"status":"online"},
+				"XETHZEUR":{"altname":"ETHEUR","wsname":"ETH/EUR","base":"XETH","quote":"ZEUR","tick_size":"0.01","lot_decimals":8,"status":"online"},
+				"SOLUSD":{"altname":"SOLUSD","wsname":"SOL/USD","base":"SOL","quote":"ZUSD","tick_size":"0.01","lot_decimals":8,"status":"online"}
+			}}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(jsonResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient("", "")
+	client.baseURL = server.URL
+	client.client = server.Client()
+	return client
+}
+
+func TestNormalizeAsset(t *testing.T) {
+	tests := map[string]string{"XXBT": "BTC", "XBT": "BTC", "XETH": "ETH", "ZEUR": "EUR", "XXDG": "DOGE", "SOL": "SOL"}
+	for code, want := range tests {
+		if got := NormalizeAsset(code); got != want {
+			t.Errorf("NormalizeAsset(%q) = %q; want %q", code, got, want)
+		}
+	}
+}
+
+func TestGet24HourTickerData(t *testing.T) {
+	client := newTestClient(t)
+
+	tickerData, err := client.Get24HourTickerData()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(tickerData) != 3 {
+		t.Fatalf("Expected 3 tickers, but got %d", len(tickerData))
+	}
+	if tickerData[1].Symbol != "XETHZEUR" || tickerData[1].TradeCount != 40 || tickerData[1].ChangePercent != 10 {
+		t.Errorf("Expected XETHZEUR with 40 trades and a 10%% change, but got %+v", tickerData[1])
+	}
+}
+
+func TestGetTickersGainerForPairs(t *testing.T) {
+	client := newTestClient(t)
+
+	response, err := client.GetTickersGainerForPairs(0, "", "")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(response.Pairs) != 2 || response.Pairs[0] != "ETH/EUR" || response.Pairs[1] != "BTC/USD" {
+		t.Errorf("Expected [ETH/EUR BTC/USD], but got %v", response.Pairs)
+	}
+}
+
+func TestToTickerOpenTime(t *testing.T) {
+	ticker := TickerData{Symbol: "XXBTZUSD", OpenPrice: "100", LastPrice: "105"}.ToTicker()
+	if !ticker.OpenTime.Equal(ticker.CloseTime.Truncate(24*time.Hour)) || ticker.OpenTime.Hour() != 0 {
+		t.Errorf("Expected the change to open at UTC midnight, but got %v", ticker.OpenTime)
+	}
+}
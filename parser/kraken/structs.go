@@ -0,0 +1,54 @@
+package kraken
+
+import "encoding/json"
+
+// Response is the envelope of every Kraken public REST response. Result is decoded by the caller.
+type Response struct {
+	Error  []string        `json:"error"`
+	Result json.RawMessage `json:"result"`
+}
+
+// rawTicker is the array-packed ticker returned by the Ticker endpoint.
+// Two-element arrays hold the value for today and for the last 24 hours.
+type rawTicker struct {
+	A []string `json:"a"`
+	B []string `json:"b"`
+	C []string `json:"c"`
+	V []string `json:"v"`
+	P []string `json:"p"`
+	T []int64  `json:"t"`
+	L []string `json:"l"`
+	H []string `json:"h"`
+	O string   `json:"o"`
+}
+
+// TickerData represents the ticker data of a trading pair with Kraken's packed fields unfolded.
+type TickerData struct {
+	Symbol           string  `json:"symbol"`
+	AskPrice         string  `json:"askPrice"`
+	AskLotVolume     string  `json:"askLotVolume"`
+	BidPrice         string  `json:"bidPrice"`
+	BidLotVolume     string  `json:"bidLotVolume"`
+	LastPrice        string  `json:"lastPrice"`
+	LastLotVolume    string  `json:"lastLotVolume"`
+	Volume24h        string  `json:"volume24h"`
+	WeightedAvgPrice string  `json:"weightedAvgPrice"`
+	TradeCount       int64   `json:"count"`
+	LowPrice24h      string  `json:"lowPrice24h"`
+	HighPrice24h     string  `json:"highPrice24h"`
+	OpenPrice        string  `json:"openPrice"`
+	ChangePercent    float64 `json:"changePercent"`
+}
+
+// AssetPair is the AssetPairs metadata of a trading pair.
+type AssetPair struct {
+	Altname      string `json:"altname"`
+	Wsname       string `json:"wsname"`
+	Base         string `json:"base"`
+	Quote        string `json:"quote"`
+	TickSize     string `json:"tick_size"`
+	LotDecimals  int    `json:"lot_decimals"`
+	PairDecimals int    `json:"pair_decimals"`
+	OrderMin     string `json:"ordermin"`
+	Status       string `json:"status"`
+}
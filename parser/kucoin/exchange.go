@@ -0,0 +1,84 @@
+package kucoin
+
+import (
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// Name is the key the KuCoin client is registered under.
+const Name = "kucoin"
+
+// Name returns the exchange name.
+func (c *Client) Name() string {
+	return Name
+}
+
+// Markets returns the market types supported by the client.
+func (c *Client) Markets() []string {
+	return []string{common.SpotMarket}
+}
+
+// Tickers returns 24-hour ticker data for all trading pairs in the given market.
+func (c *Client) Tickers(market string) (interface{}, error) {
+	if err := common.RequireMarket(market, common.SpotMarket); err != nil {
+		return nil, err
+	}
+	return c.Get24HourTickerData()
+}
+
+// Ticker returns 24-hour ticker data for a single trading pair in the given market.
+func (c *Client) Ticker(market, pair string) (interface{}, error) {
+	if err := common.RequireMarket(market, common.SpotMarket); err != nil {
+		return nil, err
+	}
+	return c.Get24HourTickerDataSymbol(pair)
+}
+
+// Rank returns the trading pairs of the given market kept and ordered by the ranking.
+func (c *Client) Rank(market string, ranking common.Ranking, query common.GainersQuery) (interface{}, error) {
+	if err := common.RequireMarket(market, common.SpotMarket); err != nil {
+		return nil, err
+	}
+	return c.rank(ranking, query)
+}
+
+// RankPairs returns the ranked trading pairs of the given market as a pair list.
+func (c *Client) RankPairs(market string, ranking common.Ranking, query common.GainersQuery) (common.PairListResponse, error) {
+	if err := common.RequireMarket(market, common.SpotMarket); err != nil {
+		return common.PairListResponse{}, err
+	}
+	return c.rankPairs(ranking, query)
+}
+
+// NormalizedTickers returns 24-hour ticker data for all trading pairs in the given market
+// converted to the exchange-agnostic Ticker.
+func (c *Client) NormalizedTickers(market string) ([]common.Ticker, error) {
+	if err := common.RequireMarket(market, common.SpotMarket); err != nil {
+		return nil, err
+	}
+	return c.normalizedTickers()
+}
+
+// Symbols returns the metadata of every trading pair in the given market, cached in memory.
+func (c *Client) Symbols(market string) (common.Symbols, error) {
+	return c.symbols.Load(market, c.Markets(), c.loadSymbols)
+}
+
+// loadSymbols requests the metadata of every trading pair.
+func (c *Client) loadSymbols() (common.Symbols, error) {
+	list, err := c.GetSymbols()
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := make(common.Symbols, len(list))
+	for _, s := range list {
+		symbols[s.Symbol] = s.ToSymbolInfo()
+	}
+	return symbols, nil
+}
+
+// symbolsOrEmpty returns the cached metadata. Metadata is best effort: when it cannot
+// be loaded the callers fall back to splitting symbols on the dash.
+func (c *Client) symbolsOrEmpty() common.Symbols {
+	return c.symbols.OrEmpty(common.SpotMarket, c.loadSymbols)
+}
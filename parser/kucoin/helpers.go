@@ -0,0 +1,63 @@
+package kucoin
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// withChangeRate fills in the parsed change rate of every ticker.
+func withChangeRate(data []TickerData) []TickerData {
+	for i := range data {
+		if rate, err := strconv.ParseFloat(data[i].ChangeRate, 64); err == nil {
+			data[i].ChangeRateFloat = rate
+		}
+	}
+	return data
+}
+
+// ToTicker converts the KuCoin ticker to the exchange-agnostic Ticker. KuCoin reports
+// changeRate as a fraction, so it is scaled to percent, and volValue is the quote volume.
+// The open price is derived from the last price and the absolute change.
+func (t TickerData) ToTicker(at time.Time) common.Ticker {
+	base, quote := common.SplitSymbol(t.Symbol)
+	last := common.ParseFloat(t.Last)
+	return common.Ticker{
+		Exchange:      Name,
+		Market:        common.SpotMarket,
+		Symbol:        t.Symbol,
+		BaseAsset:     base,
+		QuoteAsset:    quote,
+		LastPrice:     last,
+		OpenPrice:     last - common.ParseFloat(t.ChangePrice),
+		HighPrice:     common.ParseFloat(t.High),
+		LowPrice:      common.ParseFloat(t.Low),
+		BidPrice:      common.ParseFloat(t.Buy),
+		BidQuantity:   common.ParseFloat(t.BestBidSize),
+		AskPrice:      common.ParseFloat(t.Sell),
+		AskQuantity:   common.ParseFloat(t.BestAskSize),
+		Volume:        common.ParseFloat(t.Vol),
+		QuoteVolume:   common.ParseFloat(t.VolValue),
+		ChangePercent: common.ParseFloat(t.ChangeRate) * 100,
+		OpenTime:      at.Add(-24 * time.Hour),
+		CloseTime:     at,
+	}
+}
+
+// ToSymbolInfo converts the symbol metadata to the exchange-agnostic SymbolInfo.
+func (s SymbolInfo) ToSymbolInfo() common.SymbolInfo {
+	status := "Disabled"
+	if s.EnableTrading {
+		status = "Trading"
+	}
+	return common.SymbolInfo{
+		Symbol:     s.Symbol,
+		BaseAsset:  s.BaseCurrency,
+		QuoteAsset: s.QuoteCurrency,
+		Status:     status,
+		Trading:    s.EnableTrading,
+		TickSize:   common.ParseFloat(s.PriceIncrement),
+		LotSize:    common.ParseFloat(s.BaseIncrement),
+	}
+}
@@ -0,0 +1,173 @@
+package kucoin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+const baseURL = "https://api.kucoin.com"
+
+// symbolsTTL is how long symbols metadata is cached.
+const symbolsTTL = time.Hour
+
+// PairListResponse is the Freqtrade-style pair list returned by GetTickersGainerForPairs.
+type PairListResponse = common.PairListResponse
+
+// Client is a struct representing the KuCoin API client.
+type Client struct {
+	apiKey    string
+	apiSecret string
+	baseURL   string
+	client    *http.Client
+	symbols   *common.SymbolCache
+}
+
+// NewClient creates a new instance of the KuCoin API client.
+func NewClient(apiKey, apiSecret string) *Client {
+	return &Client{
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+		baseURL:   baseURL,
+		client:    &http.Client{},
+		symbols:   common.NewSymbolCache(symbolsTTL),
+	}
+}
+
+// Get24HourTickerData gets the 24-hour tickers of every spot trading pair.
+func (c *Client) Get24HourTickerData() (*[]TickerData, error) {
+	all, err := c.allTickers()
+	if err != nil {
+		return nil, err
+	}
+	return &all.Ticker, nil
+}
+
+// Get24HourTickerDataSymbol gets the 24-hour ticker of a single trading pair, e.g. BTC-USDT.
+func (c *Client) Get24HourTickerDataSymbol(symbol string) (*[]TickerData, error) {
+	if err := c.symbolsOrEmpty().Validate(symbol); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("symbol", symbol)
+
+	var ticker TickerData
+	if err := c.get("/api/v1/market/stats", query, &ticker); err != nil {
+		return nil, err
+	}
+	if ticker.Symbol == "" {
+		return nil, fmt.Errorf("%w: %s", common.ErrUnknownSymbol, symbol)
+	}
+	data := withChangeRate([]TickerData{ticker})
+	return &data, nil
+}
+
+// Get24HourGainersTickerData returns all trading pairs with a positive price change over
+// the last 24 hours, sorted by performance (descending order).
+func (c *Client) Get24HourGainersTickerData(limit int, endingFilter string) ([]TickerData, error) {
+	return c.rank(common.RankingGainers, common.GainersQuery{Limit: limit, EndingFilter: endingFilter})
+}
+
+// GetTickersGainerForPairs returns the top gainers formatted as "BASE/QUOTE" pairs.
+func (c *Client) GetTickersGainerForPairs(limit int, endingFilter, excludeFilter string) (PairListResponse, error) {
+	query := common.GainersQuery{Limit: limit, EndingFilter: endingFilter, ExcludeFilter: excludeFilter}
+	return c.rankPairs(common.RankingGainers, query)
+}
+
+// GetSymbols returns the metadata of every trading pair.
+func (c *Client) GetSymbols() ([]SymbolInfo, error) {
+	var symbols []SymbolInfo
+	if err := c.get("/api/v2/symbols", nil, &symbols); err != nil {
+		return nil, err
+	}
+	return symbols, nil
+}
+
+// rank ranks the normalized tickers and returns the matching native tickers.
+func (c *Client) rank(ranking common.Ranking, query common.GainersQuery) ([]TickerData, error) {
+	all, err := c.allTickers()
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := common.Rank(c.toTickers(all), ranking, query)
+	return common.Pick(all.Ticker, func(t TickerData) string { return t.Symbol }, ranked), nil
+}
+
+// rankPairs ranks the normalized tickers and formats them as "BASE/QUOTE" pairs.
+func (c *Client) rankPairs(ranking common.Ranking, query common.GainersQuery) (PairListResponse, error) {
+	tickers, err := c.normalizedTickers()
+	if err != nil {
+		return PairListResponse{}, err
+	}
+	return common.PairList(common.Rank(tickers, ranking, query)), nil
+}
+
+// normalizedTickers returns the tickers converted to the exchange-agnostic Ticker.
+func (c *Client) normalizedTickers() ([]common.Ticker, error) {
+	all, err := c.allTickers()
+	if err != nil {
+		return nil, err
+	}
+	return c.toTickers(all), nil
+}
+
+// toTickers converts native tickers and enriches them with the symbols metadata.
+func (c *Client) toTickers(all AllTickers) []common.Ticker {
+	at := time.UnixMilli(all.Time).UTC()
+	tickers := make([]common.Ticker, 0, len(all.Ticker))
+	for _, t := range all.Ticker {
+		tickers = append(tickers, t.ToTicker(at))
+	}
+	c.symbolsOrEmpty().Enrich(tickers)
+	return tickers
+}
+
+func (c *Client) allTickers() (AllTickers, error) {
+	var all AllTickers
+	if err := c.get("/api/v1/market/allTickers", nil, &all); err != nil {
+		return AllTickers{}, err
+	}
+	all.Ticker = withChangeRate(all.Ticker)
+	return all, nil
+}
+
+// get performs a GET request against the KuCoin API and decodes the data of the response into out.
+func (c *Client) get(path string, query url.Values, out interface{}) error {
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("creating request failed: %v", err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("executing request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("received non-OK response status: %s", res.Status)
+	}
+
+	var response Response
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("decoding response failed: %v", err)
+	}
+	if response.Code != "200000" {
+		return fmt.Errorf("kucoin error %s: %s", response.Code, response.Msg)
+	}
+	if err := json.Unmarshal(response.Data, out); err != nil {
+		return fmt.Errorf("decoding response data failed: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package kucoin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+func newTestClient(t *testing.T) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var jsonResponse string
+		switch r.URL.Path {
+		case "/api/v1/market/allTickers":
+			jsonResponse = `{"code":"200000","data":{"time":1700000000000,"ticker":[
+				{"symbol":"BTC-USDT","buy":"104.9","sell":"105.1","changeRate":"0.05","changePrice":"5","high":"106","low":"99","vol":"10","volValue":"1050","last":"105"},
+				{"symbol":"NEW-USDT","buy":"1.19","sell":"1.21","changeRate":"0.2","changePrice":"0.2","high":"1.3","low":"0.9","vol":"1000","volValue":"1200","last":"1.2"},
+				{"symbol":"ETH-BTC","buy":"0.05","sell":"0.051","changeRate":"0.5","changePrice":"0.017","high":"0.06","low":"0.03","vol":"10","volValue":"0.5","last":"0.05"},
+				{"symbol":"XRP-USDT","buy":"0.49","sell":"0.51","changeRate":"-0.1","changePrice":"-0.05","high":"0.6","low":"0.4","vol":"100","volValue":"50","last":"0.5"}
+			]}}`
+		case "/api/v1/market/stats":
+			jsonResponse = `{"code":"200000","data":{"time":1700000000000,"symbol":"BTC-USDT","changeRate":"0.05","changePrice":"5","last":"105"}}`
+		case "/api/v2/symbols":
+			jsonResponse = `{"code":"200000","data":[
+				{"symbol":"BTC-USDT","baseCurrency":"BTC","quoteCurrency":"USDT","priceIncrement":"0.1","baseIncrement":"0.00000001","enableTrading":true},
+				{"symbol":"NEW-USDT","baseCurrency":"NEW","quoteCurrency":"USDT","priceIncrement":"0.0001","baseIncrement":"0.01","enableTrading":true},
+				{"symbol":"ETH-BTC","baseCurrency":"ETH","quoteCurrency":"BTC","priceIncrement":"0.000001","baseIncrement":"0.0001","enableTrading":true},
+				{"symbol":"XRP-USDT","baseCurrency":"XRP","quoteCurrency":"USDT","priceIncrement":"0.0001","baseIncrement":"0.01","enableTrading":true}
+			]}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(jsonResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient("", "")
+	client.baseURL = server.URL
+	client.client = server.Client()
+	return client
+}
+
+func TestGet24HourTickerData(t *testing.T) {
+	client := newTestClient(t)
+
+	tickerData, err := client.Get24HourTickerData()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(*tickerData) != 4 {
+		t.Fatalf("Expected 4 tickers, but got %d", len(*tickerData))
+	}
+	if (*tickerData)[1].ChangeRateFloat != 0.2 {
+		t.Errorf("Expected a change rate of 0.2, but got %v", (*tickerData)[1].ChangeRateFloat)
+	}
+}
+
+func TestNormalizedTickersScalesChangeRate(t *testing.T) {
+	client := newTestClient(t)
+
+	tickers, err := client.NormalizedTickers("spot")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if tickers[0].ChangePercent != 5 || tickers[0].OpenPrice != 100 || tickers[0].QuoteAsset != "USDT" {
+		t.Errorf("Expected a 5%% change from an open of 100 quoted in USDT, but got %+v", tickers[0])
+	}
+}
+
+func TestGet24HourTickerDataSymbol(t *testing.T) {
+	client := newTestClient(t)
+
+	tickerData, err := client.Get24HourTickerDataSymbol("BTC-USDT")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if (*tickerData)[0].Symbol != "BTC-USDT" {
+		t.Errorf("Expected symbol 'BTC-USDT', but got %s", (*tickerData)[0].Symbol)
+	}
+
+	if _, err := client.Get24HourTickerDataSymbol("NOPE-USDT"); !errors.Is(err, common.ErrUnknownSymbol) {
+		t.Errorf("Expected ErrUnknownSymbol, but got %v", err)
+	}
+}
+
+func TestGetTickersGainerForPairs(t *testing.T) {
+	client := newTestClient(t)
+
+	response, err := client.GetTickersGainerForPairs(100, "USDT", "BNB")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(response.Pairs) != 2 || response.Pairs[0] != "NEW/USDT" || response.Pairs[1] != "BTC/USDT" {
+		t.Errorf("Expected [NEW/USDT BTC/USDT], but got %v", response.Pairs)
+	}
+}
@@ -0,0 +1,46 @@
+package kucoin
+
+import "encoding/json"
+
+// Response is the envelope of every KuCoin REST response. Data is decoded by the caller.
+type Response struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data json.RawMessage `json:"data"`
+}
+
+// AllTickers is the data of the allTickers endpoint.
+type AllTickers struct {
+	Time   int64        `json:"time"`
+	Ticker []TickerData `json:"ticker"`
+}
+
+// TickerData represents the 24-hour ticker data for a trading pair.
+type TickerData struct {
+	Symbol          string  `json:"symbol"`
+	SymbolName      string  `json:"symbolName,omitempty"`
+	Buy             string  `json:"buy"`
+	BestBidSize     string  `json:"bestBidSize,omitempty"`
+	Sell            string  `json:"sell"`
+	BestAskSize     string  `json:"bestAskSize,omitempty"`
+	ChangeRate      string  `json:"changeRate"`
+	ChangePrice     string  `json:"changePrice"`
+	High            string  `json:"high"`
+	Low             string  `json:"low"`
+	Vol             string  `json:"vol"`
+	VolValue        string  `json:"volValue"`
+	Last            string  `json:"last"`
+	AveragePrice    string  `json:"averagePrice"`
+	Time            int64   `json:"time,omitempty"`
+	ChangeRateFloat float64 `json:"change_rate_float"`
+}
+
+// SymbolInfo is the metadata of a trading pair.
+type SymbolInfo struct {
+	Symbol         string `json:"symbol"`
+	BaseCurrency   string `json:"baseCurrency"`
+	QuoteCurrency  string `json:"quoteCurrency"`
+	PriceIncrement string `json:"priceIncrement"`
+	BaseIncrement  string `json:"baseIncrement"`
+	EnableTrading  bool   `json:"enableTrading"`
+}
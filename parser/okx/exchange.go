@@ -0,0 +1,44 @@
+package okx
+
+import (
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// Name is the key the OKX client is registered under.
+const Name = "okx"
+
+// Name returns the exchange name.
+func (c *Client) Name() string {
+	return Name
+}
+
+// Markets returns the market types supported by the client, spot first.
+func (c *Client) Markets() []string {
+	return []string{string(Spot), string(Swap), string(Futures), string(Option)}
+}
+
+// Tickers returns 24-hour ticker data for all instruments in the given market.
+func (c *Client) Tickers(market string) (interface{}, error) {
+	return c.Get24HourTickerData(Market(market))
+}
+
+// Ticker returns 24-hour ticker data for a single instrument in the given market.
+func (c *Client) Ticker(market, pair string) (interface{}, error) {
+	return c.GetTickerForPair(Market(market), pair)
+}
+
+// Rank returns the instruments of the given market kept and ordered by the ranking.
+func (c *Client) Rank(market string, ranking common.Ranking, query common.GainersQuery) (interface{}, error) {
+	return c.rank(Market(market), ranking, query)
+}
+
+// RankPairs returns the ranked instruments of the given market as a pair list.
+func (c *Client) RankPairs(market string, ranking common.Ranking, query common.GainersQuery) (common.PairListResponse, error) {
+	return c.rankPairs(Market(market), ranking, query)
+}
+
+// NormalizedTickers returns 24-hour ticker data for all instruments in the given market
+// converted to the exchange-agnostic Ticker.
+func (c *Client) NormalizedTickers(market string) ([]common.Ticker, error) {
+	return c.normalizedTickers(Market(market))
+}
@@ -0,0 +1,70 @@
+package okx
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// IsValidMarket checks if the provided market is valid.
+func IsValidMarket(m Market) bool {
+	switch m {
+	case Spot, Swap, Futures, Option:
+		return true
+	default:
+		return false
+	}
+}
+
+// withChangePercent fills in the 24-hour percent change, which OKX does not report,
+// from the open and last prices of every ticker.
+func withChangePercent(data []TickerData) []TickerData {
+	for i := range data {
+		data[i].ChangePercent = common.ChangePercent(common.ParseFloat(data[i].Open24h), common.ParseFloat(data[i].Last))
+	}
+	return data
+}
+
+// ToTicker converts the OKX ticker of the given market to the exchange-agnostic Ticker.
+// For spot, vol24h is in the base currency and volCcy24h in the quote currency; for
+// derivatives volCcy24h is in the base currency, so the quote volume is estimated from
+// the last price.
+func (t TickerData) ToTicker(market Market) common.Ticker {
+	base, quote := common.SplitSymbol(t.InstID)
+	if parts := strings.Split(t.InstID, "-"); len(parts) > 2 {
+		quote = parts[1]
+	}
+
+	last := common.ParseFloat(t.Last)
+	volume := common.ParseFloat(t.Vol24h)
+	quoteVolume := common.ParseFloat(t.VolCcy24h)
+	if market != Spot {
+		volume = quoteVolume
+		quoteVolume = volume * last
+	}
+
+	ts, _ := strconv.ParseInt(t.Ts, 10, 64)
+	closeTime := time.UnixMilli(ts).UTC()
+	return common.Ticker{
+		Exchange:      Name,
+		Market:        string(market),
+		Symbol:        t.InstID,
+		BaseAsset:     base,
+		QuoteAsset:    quote,
+		LastPrice:     last,
+		OpenPrice:     common.ParseFloat(t.Open24h),
+		HighPrice:     common.ParseFloat(t.High24h),
+		LowPrice:      common.ParseFloat(t.Low24h),
+		BidPrice:      common.ParseFloat(t.BidPx),
+		BidQuantity:   common.ParseFloat(t.BidSz),
+		AskPrice:      common.ParseFloat(t.AskPx),
+		AskQuantity:   common.ParseFloat(t.AskSz),
+		Volume:        volume,
+		QuoteVolume:   quoteVolume,
+		ChangePercent: common.ChangePercent(common.ParseFloat(t.Open24h), last),
+		OpenTime:      closeTime.Add(-24 * time.Hour),
+		CloseTime:     closeTime,
+	}
+}
@@ -0,0 +1,185 @@
+package okx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+const baseURL = "https://www.okx.com"
+
+// symbolsTTL is how long instruments metadata is cached.
+const symbolsTTL = time.Hour
+
+// defaultOptionFamily is the instrument family queried for options, which OKX only
+// lists per underlying. It is the only family served; the option market takes no
+// family parameter.
+const defaultOptionFamily = "BTC-USD"
+
+type Market string
+
+const (
+	Spot    Market = "spot"
+	Swap    Market = "swap"
+	Futures Market = "futures"
+	Option  Market = "option"
+)
+
+// PairListResponse is the Freqtrade-style pair list returned by GetTickersGainerForPairs.
+type PairListResponse = common.PairListResponse
+
+// Client is a struct representing the OKX API client.
+type Client struct {
+	apiKey    string
+	apiSecret string
+	baseURL   string
+	client    *http.Client
+	symbols   *common.SymbolCache
+}
+
+// NewClient creates a new instance of the OKX API client.
+func NewClient(apiKey, apiSecret string) *Client {
+	return &Client{
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+		baseURL:   baseURL,
+		client:    &http.Client{},
+		symbols:   common.NewSymbolCache(symbolsTTL),
+	}
+}
+
+// Get24HourTickerData returns 24-hour ticker data for all instruments of a given market.
+func (c *Client) Get24HourTickerData(market Market) ([]TickerData, error) {
+	if !IsValidMarket(market) {
+		return nil, fmt.Errorf("invalid market type: %s", market)
+	}
+
+	var data []TickerData
+	if err := c.get("/api/v5/market/tickers", marketQuery(market), &data); err != nil {
+		return nil, err
+	}
+	return withChangePercent(data), nil
+}
+
+// GetTickerForPair returns 24-hour ticker data for a single instrument, e.g. BTC-USDT.
+func (c *Client) GetTickerForPair(market Market, instID string) (TickerData, error) {
+	if !IsValidMarket(market) {
+		return TickerData{}, fmt.Errorf("invalid market type: %s", market)
+	}
+	if err := c.symbolsOrEmpty(market).Validate(instID); err != nil {
+		return TickerData{}, err
+	}
+
+	query := url.Values{}
+	query.Set("instId", instID)
+
+	var data []TickerData
+	if err := c.get("/api/v5/market/ticker", query, &data); err != nil {
+		return TickerData{}, err
+	}
+	if len(data) == 0 {
+		return TickerData{}, fmt.Errorf("%w: %s", common.ErrUnknownSymbol, instID)
+	}
+	return withChangePercent(data)[0], nil
+}
+
+// Get24HourGainersTickerData returns all instruments with a positive price change
+// over the last 24 hours, sorted by performance (descending order).
+func (c *Client) Get24HourGainersTickerData(market Market, limit int, endingFilter string) ([]TickerData, error) {
+	return c.rank(market, common.RankingGainers, common.GainersQuery{Limit: limit, EndingFilter: endingFilter})
+}
+
+// GetTickersGainerForPairs returns the top gainers formatted as "BASE/QUOTE" pairs.
+func (c *Client) GetTickersGainerForPairs(market Market, limit int, endingFilter, excludeFilter string) (PairListResponse, error) {
+	query := common.GainersQuery{Limit: limit, EndingFilter: endingFilter, ExcludeFilter: excludeFilter}
+	return c.rankPairs(market, common.RankingGainers, query)
+}
+
+// rank ranks the normalized tickers of the market and returns the matching native tickers.
+func (c *Client) rank(market Market, ranking common.Ranking, query common.GainersQuery) ([]TickerData, error) {
+	data, err := c.Get24HourTickerData(market)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := common.Rank(c.toTickers(market, data), ranking, query)
+	return common.Pick(data, func(t TickerData) string { return t.InstID }, ranked), nil
+}
+
+// rankPairs ranks the normalized tickers and formats them as "BASE/QUOTE" pairs.
+func (c *Client) rankPairs(market Market, ranking common.Ranking, query common.GainersQuery) (PairListResponse, error) {
+	tickers, err := c.normalizedTickers(market)
+	if err != nil {
+		return PairListResponse{}, err
+	}
+	return common.PairList(common.Rank(tickers, ranking, query)), nil
+}
+
+// normalizedTickers returns the tickers of the market converted to the exchange-agnostic Ticker.
+func (c *Client) normalizedTickers(market Market) ([]common.Ticker, error) {
+	data, err := c.Get24HourTickerData(market)
+	if err != nil {
+		return nil, err
+	}
+	return c.toTickers(market, data), nil
+}
+
+// toTickers converts native tickers and enriches them with the market metadata.
+func (c *Client) toTickers(market Market, data []TickerData) []common.Ticker {
+	tickers := make([]common.Ticker, 0, len(data))
+	for _, t := range data {
+		tickers = append(tickers, t.ToTicker(market))
+	}
+	c.symbolsOrEmpty(market).Enrich(tickers)
+	return tickers
+}
+
+// get performs a GET request against the OKX API and decodes the data of the response into out.
+func (c *Client) get(path string, query url.Values, out interface{}) error {
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("creating request failed: %v", err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("executing request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("received non-OK response status: %s", res.Status)
+	}
+
+	var response Response
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("decoding response failed: %v", err)
+	}
+	if response.Code != "0" {
+		return fmt.Errorf("okx error %s: %s", response.Code, response.Msg)
+	}
+	if err := json.Unmarshal(response.Data, out); err != nil {
+		return fmt.Errorf("decoding response data failed: %v", err)
+	}
+	return nil
+}
+
+// marketQuery returns the query selecting the instruments of a market.
+func marketQuery(market Market) url.Values {
+	query := url.Values{}
+	query.Set("instType", strings.ToUpper(string(market)))
+	if market == Option {
+		query.Set("instFamily", defaultOptionFamily)
+	}
+	return query
+}
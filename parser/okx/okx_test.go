@@ -0,0 +1,110 @@
+package okx
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+func newTestClient(t *testing.T) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var jsonResponse string
+		switch r.URL.Path {
+		case "/api/v5/market/tickers":
+			if r.URL.Query().Get("instType") != "SPOT" {
+				t.Errorf("Expected instType SPOT, but got %s", r.URL.Query().Get("instType"))
+			}
+			jsonResponse = `{"code":"0","msg":"","data":[
+				{"instType":"SPOT","instId":"BTC-USDT","last":"105","open24h":"100","high24h":"106","low24h":"99","vol24h":"10","volCcy24h":"1050","bidPx":"104.9","askPx":"105.1","ts":"1700000000000"},
+				{"instType":"SPOT","instId":"ETH-USDT","last":"110","open24h":"100","high24h":"111","low24h":"98","vol24h":"5","volCcy24h":"550","bidPx":"109.9","askPx":"110.1","ts":"1700000000000"},
+				{"instType":"SPOT","instId":"SOL-BTC","last":"120","open24h":"100","high24h":"121","low24h":"97","vol24h":"1","volCcy24h":"120","bidPx":"119","askPx":"121","ts":"1700000000000"},
+				{"instType":"SPOT","instId":"XRP-USDT","last":"90","open24h":"100","high24h":"101","low24h":"89","vol24h":"7","volCcy24h":"630","bidPx":"89.9","askPx":"90.1","ts":"1700000000000"}
+			]}`
+		case "/api/v5/market/ticker":
+			jsonResponse = `{"code":"0","msg":"","data":[
+				{"instType":"SPOT","instId":"BTC-USDT","last":"105","open24h":"100","ts":"1700000000000"}
+			]}`
+		case "/api/v5/public/instruments":
+			jsonResponse = `{"code":"0","msg":"","data":[
+				{"instType":"SPOT","instId":"BTC-USDT","baseCcy":"BTC","quoteCcy":"USDT","tickSz":"0.1","lotSz":"0.00000001","state":"live"},
+				{"instType":"SPOT","instId":"ETH-USDT","baseCcy":"ETH","quoteCcy":"USDT","tickSz":"0.01","lotSz":"0.000001","state":"live"},
+				{"instType":"SPOT","instId":"SOL-BTC","baseCcy":"SOL","quoteCcy":"BTC","tickSz":"0.0000001","lotSz":"0.0001","state":"live"},
+				{"instType":"SPOT","instId":"XRP-USDT","baseCcy":"XRP","quoteCcy":"USDT","tickSz":"0.0001","lotSz":"0.000001","state":"live"}
+			]}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(jsonResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient("", "")
+	client.baseURL = server.URL
+	client.client = server.Client()
+	return client
+}
+
+func TestGet24HourTickerData(t *testing.T) {
+	client := newTestClient(t)
+
+	tickerData, err := client.Get24HourTickerData(Spot)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(tickerData) != 4 {
+		t.Fatalf("Expected 4 tickers, but got %d", len(tickerData))
+	}
+	if tickerData[0].ChangePercent != 5 {
+		t.Errorf("Expected a change of 5%% computed from open and last, but got %v", tickerData[0].ChangePercent)
+	}
+}
+
+func TestGetTickerForPair(t *testing.T) {
+	client := newTestClient(t)
+
+	ticker, err := client.GetTickerForPair(Spot, "BTC-USDT")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if ticker.InstID != "BTC-USDT" {
+		t.Errorf("Expected instrument 'BTC-USDT', but got %s", ticker.InstID)
+	}
+
+	if _, err := client.GetTickerForPair(Spot, "NOPE-USDT"); !errors.Is(err, common.ErrUnknownSymbol) {
+		t.Errorf("Expected ErrUnknownSymbol, but got %v", err)
+	}
+}
+
+func TestGet24HourGainersTickerData(t *testing.T) {
+	client := newTestClient(t)
+
+	gainers, err := client.Get24HourGainersTickerData(Spot, 0, "USDT")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(gainers) != 2 {
+		t.Fatalf("Expected 2 USDT gainers, but got %d", len(gainers))
+	}
+	if gainers[0].InstID != "ETH-USDT" || gainers[1].InstID != "BTC-USDT" {
+		t.Errorf("Expected ETH-USDT then BTC-USDT, but got %s then %s", gainers[0].InstID, gainers[1].InstID)
+	}
+}
+
+func TestGetTickersGainerForPairs(t *testing.T) {
+	client := newTestClient(t)
+
+	response, err := client.GetTickersGainerForPairs(Spot, 1, "USDT", "BNB")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(response.Pairs) != 1 || response.Pairs[0] != "ETH/USDT" {
+		t.Errorf("Expected [ETH/USDT], but got %v", response.Pairs)
+	}
+}
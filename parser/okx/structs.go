@@ -0,0 +1,45 @@
+package okx
+
+import "encoding/json"
+
+// Response is the envelope of every OKX v5 REST response. Data is decoded by the caller.
+type Response struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data json.RawMessage `json:"data"`
+}
+
+// TickerData represents the 24-hour ticker data of an instrument.
+type TickerData struct {
+	InstType      string  `json:"instType"`
+	InstID        string  `json:"instId"`
+	Last          string  `json:"last"`
+	LastSz        string  `json:"lastSz"`
+	AskPx         string  `json:"askPx"`
+	AskSz         string  `json:"askSz"`
+	BidPx         string  `json:"bidPx"`
+	BidSz         string  `json:"bidSz"`
+	Open24h       string  `json:"open24h"`
+	High24h       string  `json:"high24h"`
+	Low24h        string  `json:"low24h"`
+	VolCcy24h     string  `json:"volCcy24h"`
+	Vol24h        string  `json:"vol24h"`
+	Ts            string  `json:"ts"`
+	SodUtc0       string  `json:"sodUtc0"`
+	SodUtc8       string  `json:"sodUtc8"`
+	ChangePercent float64 `json:"changePercent"`
+}
+
+// Instrument is the public instruments metadata of a trading pair.
+type Instrument struct {
+	InstType   string `json:"instType"`
+	InstID     string `json:"instId"`
+	InstFamily string `json:"instFamily"`
+	Uly        string `json:"uly"`
+	BaseCcy    string `json:"baseCcy"`
+	QuoteCcy   string `json:"quoteCcy"`
+	SettleCcy  string `json:"settleCcy"`
+	TickSz     string `json:"tickSz"`
+	LotSz      string `json:"lotSz"`
+	State      string `json:"state"`
+}
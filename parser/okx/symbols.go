@@ -0,0 +1,72 @@
+package okx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+)
+
+// GetInstruments returns the metadata of every instrument in the given market.
+func (c *Client) GetInstruments(market Market) ([]Instrument, error) {
+	if !IsValidMarket(market) {
+		return nil, fmt.Errorf("invalid market type: %s", market)
+	}
+
+	var instruments []Instrument
+	if err := c.get("/api/v5/public/instruments", marketQuery(market), &instruments); err != nil {
+		return nil, err
+	}
+	return instruments, nil
+}
+
+// Symbols returns the metadata of every instrument in the given market, cached in memory.
+func (c *Client) Symbols(market string) (common.Symbols, error) {
+	m := Market(market)
+	return c.symbols.Get(market, func() (common.Symbols, error) {
+		instruments, err := c.GetInstruments(m)
+		if err != nil {
+			return nil, err
+		}
+
+		symbols := make(common.Symbols, len(instruments))
+		for _, i := range instruments {
+			symbols[i.InstID] = i.ToSymbolInfo()
+		}
+		return symbols, nil
+	})
+}
+
+// ToSymbolInfo converts the instrument to the exchange-agnostic SymbolInfo. Derivatives
+// carry no base and quote currency, so they are taken from the instrument family.
+func (i Instrument) ToSymbolInfo() common.SymbolInfo {
+	base, quote := i.BaseCcy, i.QuoteCcy
+	if base == "" || quote == "" {
+		family := i.InstFamily
+		if family == "" {
+			family = i.Uly
+		}
+		if parts := strings.Split(family, "-"); len(parts) == 2 {
+			base, quote = parts[0], parts[1]
+		}
+	}
+	return common.SymbolInfo{
+		Symbol:     i.InstID,
+		BaseAsset:  base,
+		QuoteAsset: quote,
+		Status:     i.State,
+		Trading:    i.State == "live",
+		TickSize:   common.ParseFloat(i.TickSz),
+		LotSize:    common.ParseFloat(i.LotSz),
+	}
+}
+
+// symbolsOrEmpty returns the cached metadata of the market. Metadata is best effort:
+// when it cannot be loaded the callers fall back to splitting instrument IDs.
+func (c *Client) symbolsOrEmpty(market Market) common.Symbols {
+	symbols, err := c.Symbols(string(market))
+	if err != nil {
+		return common.Symbols{}
+	}
+	return symbols
+}
@@ -1,20 +1,63 @@
 package parser
 
 import (
+	"fmt"
+
 	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/binance"
 	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/bybit"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/coinbase"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/kraken"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/kucoin"
+	"github.com/cploutarchou/CryptoGainerAPI-Client/parser/okx"
 )
 
 type Parser interface {
 	Binance() *binance.Client
 	Bybit() *bybit.Client
+	// Exchange returns the configured exchange registered under name.
+	Exchange(name string) (Exchange, bool)
+	// Exchanges returns every configured exchange in registration order.
+	Exchanges() []Exchange
+	// AggregateGainers ranks the gainers of the given markets of the named exchanges,
+	// or of every configured exchange when no name is given. Without markets, every
+	// market except options is ranked.
+	AggregateGainers(names, markets []string, query common.GainersQuery) (AggregatedGainers, error)
+	// Spreads compares the given market of two named exchanges pair by pair.
+	Spreads(first, second, market string) ([]common.Spread, error)
+	// Thresholds returns the configured default gainer thresholds.
+	Thresholds() common.Thresholds
 }
 
+var (
+	_ Exchange = (*binance.Client)(nil)
+	_ Exchange = (*bybit.Client)(nil)
+	_ Exchange = (*okx.Client)(nil)
+	_ Exchange = (*kraken.Client)(nil)
+	_ Exchange = (*coinbase.Client)(nil)
+	_ Exchange = (*kucoin.Client)(nil)
+
+	_ OptionExchange = (*bybit.Client)(nil)
+
+	_ BatchExchange = (*binance.Client)(nil)
+	_ BatchExchange = (*bybit.Client)(nil)
+
+	_ WindowExchange = (*binance.Client)(nil)
+	_ WindowExchange = (*bybit.Client)(nil)
+
+	_ CandleExchange = (*binance.Client)(nil)
+	_ CandleExchange = (*bybit.Client)(nil)
+
+	_ DepthExchange = (*binance.Client)(nil)
+	_ DepthExchange = (*bybit.Client)(nil)
+
+	_ TradeExchange = (*binance.Client)(nil)
+	_ TradeExchange = (*bybit.Client)(nil)
+)
+
 type parserImp struct {
-	binanceKey    string
-	binanceSecret string
-	bybitKey      string
-	bybitSecret   string
+	registry   *Registry
+	thresholds common.Thresholds
 }
 
 func NewBinance(apiKey, apiSecret string) *binance.Client {
@@ -23,23 +66,86 @@ func NewBinance(apiKey, apiSecret string) *binance.Client {
 func NewBybit(apiKey, apiSecret string) *bybit.Client {
 	return bybit.NewClient(apiKey, apiSecret)
 }
+func NewOkx(apiKey, apiSecret string) *okx.Client {
+	return okx.NewClient(apiKey, apiSecret)
+}
+func NewKraken(apiKey, apiSecret string) *kraken.Client {
+	return kraken.NewClient(apiKey, apiSecret)
+}
+func NewCoinbase(apiKey, apiSecret string) *coinbase.Client {
+	return coinbase.NewClient(apiKey, apiSecret)
+}
+func NewKucoin(apiKey, apiSecret string) *kucoin.Client {
+	return kucoin.NewClient(apiKey, apiSecret)
+}
 
 func (p *parserImp) Binance() *binance.Client {
-	return NewBinance(p.binanceKey, p.binanceSecret)
+	exchange, _ := p.registry.Get(binance.Name)
+	return exchange.(*binance.Client)
 }
 func (p *parserImp) Bybit() *bybit.Client {
-	return NewBybit(p.binanceKey, p.binanceSecret)
+	exchange, _ := p.registry.Get(bybit.Name)
+	return exchange.(*bybit.Client)
 }
 
-func New(config Config) (Parser, error) {
-	var parser = new(parserImp)
-	if config.Binance != nil {
-		parser.binanceKey = config.Binance.ApiKey
-		parser.binanceSecret = config.Binance.SecretKey
+func (p *parserImp) Exchange(name string) (Exchange, bool) {
+	return p.registry.Get(name)
+}
+
+func (p *parserImp) Exchanges() []Exchange {
+	return p.registry.All()
+}
+
+func (p *parserImp) AggregateGainers(names, markets []string, query common.GainersQuery) (AggregatedGainers, error) {
+	exchanges, err := p.lookup(names)
+	if err != nil {
+		return AggregatedGainers{}, err
+	}
+	return AggregateGainers(exchanges, markets, query)
+}
+
+func (p *parserImp) Spreads(first, second, market string) ([]common.Spread, error) {
+	exchanges, err := p.lookup([]string{first, second})
+	if err != nil {
+		return nil, err
 	}
-	if config.Bybit != nil {
-		parser.bybitKey = config.Bybit.ApiKey
-		parser.bybitSecret = config.Bybit.SecretKey
+	return Spreads(exchanges[0], exchanges[1], market)
+}
+
+func (p *parserImp) Thresholds() common.Thresholds {
+	return p.thresholds
+}
+
+// lookup returns the exchanges registered under names, or every exchange when names is empty.
+func (p *parserImp) lookup(names []string) ([]Exchange, error) {
+	if len(names) == 0 {
+		return p.registry.All(), nil
+	}
+
+	exchanges := make([]Exchange, 0, len(names))
+	for _, name := range names {
+		exchange, ok := p.registry.Get(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown exchange: %s", name)
+		}
+		exchanges = append(exchanges, exchange)
+	}
+	return exchanges, nil
+}
+
+func New(config Config) (Parser, error) {
+	registry := NewRegistry()
+	for _, exchange := range []Exchange{
+		NewBinance(config.Binance.keys()),
+		NewBybit(config.Bybit.keys()),
+		NewOkx(config.Okx.keys()),
+		NewKraken(config.Kraken.keys()),
+		NewCoinbase(config.Coinbase.keys()),
+		NewKucoin(config.Kucoin.keys()),
+	} {
+		if err := registry.Register(exchange); err != nil {
+			return nil, err
+		}
 	}
-	return parser, nil
+	return &parserImp{registry: registry, thresholds: config.Thresholds}, nil
 }
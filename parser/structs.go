@@ -1,16 +1,42 @@
 package parser
 
+import "github.com/cploutarchou/CryptoGainerAPI-Client/parser/common"
+
 type Config struct {
-	Binance *Binance
-	Bybit   *Bybit
+	Binance  *Binance
+	Bybit    *Bybit
+	Okx      *Okx
+	Kraken   *Kraken
+	Coinbase *Coinbase
+	Kucoin   *Kucoin
+
+	// Thresholds are the default gainer thresholds, used for every bound a request leaves
+	// unset. The change bounds only apply to the gainers; see common.Thresholds.ForRanking.
+	Thresholds common.Thresholds
 }
 
-type Binance struct {
+// Credentials holds the API key pair of an exchange.
+type Credentials struct {
 	ApiKey    string
 	SecretKey string
 }
 
-type Bybit struct {
-	ApiKey    string
-	SecretKey string
+type Binance = Credentials
+
+type Bybit = Credentials
+
+type Okx = Credentials
+
+type Kraken = Credentials
+
+type Coinbase = Credentials
+
+type Kucoin = Credentials
+
+// keys returns the key pair, or empty keys when no credentials are configured.
+func (c *Credentials) keys() (string, string) {
+	if c == nil {
+		return "", ""
+	}
+	return c.ApiKey, c.SecretKey
 }